@@ -7,25 +7,223 @@ terraform {
 
 }
 
-provider "vellum" {}
+provider "vellum" {
+  # Refuse to plan or apply when VELLUM_API_KEY belongs to another workspace.
+  expected_workspace_name = "production"
+
+  # Call a self-hosted Vellum instead of production.
+  # base_url = "https://vellum.example.com"
+
+  # Plan-only pipelines can set VELLUM_READ_ONLY=true instead, so that an
+  # accidental apply fails before it changes anything.
+  read_only = false
+
+  # Reach Vellum through a corporate egress proxy with a private CA.
+  # proxy_url    = "http://proxy.example.com:3128"
+  # ca_cert_file = "${path.module}/corporate-ca.pem"
+  request_timeout = "60s"
+
+  # Reads of the same entity are shared for a few seconds; disable that to
+  # always read from Vellum.
+  # disable_read_cache = true
+}
 
 data "vellum_document_index" "reference" {
   name = "reference"
 }
 
 resource "vellum_document_index" "managed" {
-  label = "Managed Index"
-  name  = "managed-index"
+  label     = "Managed Index"
+  name      = "managed-index"
+  folder_id = vellum_folder.rag_prod.id
+
+  timeouts {
+    create = "30m"
+    update = "5m"
+  }
+}
+
+resource "vellum_document_index_sync" "knowledge_base" {
+  document_index_id   = vellum_document_index.managed.id
+  directory           = "${path.module}/knowledge-base"
+  include             = ["**/*.md", "**/*.pdf"]
+  exclude             = ["drafts/**"]
+  external_id_prefix  = "kb/"
+  wait_for_processing = false
+}
+
+resource "vellum_document" "handbook" {
+  label              = "Employee Handbook"
+  external_id        = "handbook"
+  source_path        = "${path.module}/handbook.md"
+  document_index_ids = [vellum_document_index.managed.id]
+  metadata = {
+    team = "people-ops"
+  }
+
+  timeouts {
+    create = "30m"
+  }
+}
+
+check "handbook_is_searchable" {
+  data "vellum_document_index_search" "golden" {
+    document_index_id = vellum_document_index.managed.id
+    query             = "How many vacation days do employees get?"
+    top_k             = 3
+
+    weights = {
+      semantic_similarity = 0.8
+      keywords            = 0.2
+    }
+
+    metadata_filters = [
+      {
+        field    = "team"
+        operator = "="
+        value    = "people-ops"
+      },
+    ]
+  }
+
+  assert {
+    condition     = contains(data.vellum_document_index_search.golden.results[*].document_id, vellum_document.handbook.id)
+    error_message = "The golden query no longer returns the handbook."
+  }
+}
+
+data "vellum_documents" "failed" {
+  document_index_id = vellum_document_index.managed.id
+  processing_states = ["FAILED"]
+}
+
+output "failed_documents" {
+  value = {
+    for document in data.vellum_documents.failed.documents :
+    document.label => document.processing_failure_reason
+  }
+}
+
+resource "vellum_deployment" "support_bot" {
+  name        = "support-bot"
+  label       = "Support Bot"
+  description = "Answers customer questions from the knowledge base"
+  environment = "PRODUCTION"
+}
+
+data "vellum_deployment" "reference" {
+  name = "support-bot-legacy"
+}
+
+data "vellum_deployment_release_tag" "support_bot_canary" {
+  deployment_id = vellum_deployment.support_bot.id
+  name          = "canary"
+}
+
+resource "vellum_deployment_release_tag" "support_bot_stable" {
+  deployment_id = vellum_deployment.support_bot.id
+  name          = "stable"
+  release_id    = data.vellum_deployment_release_tag.support_bot_canary.release_id
+}
+
+data "vellum_workflow_deployment" "triage" {
+  name = "ticket-triage"
+}
+
+resource "vellum_workflow_release_tag" "triage_production" {
+  workflow_deployment_id = data.vellum_workflow_deployment.triage.id
+  name                   = "production"
+  release_id             = "5b0c4a9e-7c1f-4e0a-9d55-3f0b8a1e2c47"
 }
 
 data "vellum_ml_model" "reference" {
   name = "gpt-4o"
 }
 
+data "vellum_ml_model" "azure" {
+  hosted_by = "AZURE_OPENAI"
+  exec_config = {
+    model_identifier = "gpt-4o"
+  }
+}
+
+variable "azure_openai_api_key" {
+  type      = string
+  sensitive = true
+}
+
+resource "vellum_workspace_secret" "azure_openai" {
+  name          = "azure-openai-api-key"
+  label         = "Azure OpenAI API Key"
+  value         = var.azure_openai_api_key
+  value_version = 1
+}
+
+resource "vellum_test_suite" "support" {
+  name  = "support-answers"
+  label = "Support Answers"
+  input_variables = [
+    { key = "question", type = "STRING", required = true },
+    { key = "chat_history", type = "CHAT_HISTORY" },
+  ]
+  evaluation_variables = [
+    { key = "answer", type = "STRING" },
+  ]
+
+  # Every row needs an external_id. Only new and changed rows are uploaded.
+  test_cases_file = "${path.module}/test_cases.jsonl"
+}
+
+resource "vellum_test_suite_test_case" "refund" {
+  test_suite_id = vellum_test_suite.support.id
+  external_id   = "refund-policy"
+  label         = "Refund policy"
+  input_values = {
+    question     = "Can I get a refund after 30 days?"
+    chat_history = jsonencode([])
+  }
+  evaluation_values = {
+    answer = "Refunds are available within 30 days of purchase."
+  }
+}
+
+data "vellum_workspace" "current" {}
+
+check "workspace" {
+  assert {
+    condition     = data.vellum_workspace.current.name == "production"
+    error_message = "VELLUM_API_KEY authenticates against ${data.vellum_workspace.current.name}, not production."
+  }
+}
+
+resource "vellum_folder" "rag" {
+  name = "rag"
+
+  lifecycle {
+    precondition {
+      condition     = data.vellum_workspace.current.api_key_scope == "WORKSPACE"
+      error_message = "Use a workspace-scoped API key."
+    }
+  }
+}
+
+resource "vellum_folder" "rag_prod" {
+  name             = "prod"
+  parent_folder_id = vellum_folder.rag.id
+}
+
+data "vellum_folder" "support" {
+  path = "rag/prod/support"
+}
+
 resource "vellum_ml_model" "managed" {
   name = "my-test-model"
   family = "GPT3"
   hosted_by = "OPENAI"
   developed_by = "OPENAI"
   visibility = "PRIVATE"
+
+  timeouts {
+    delete = "2m"
+  }
 }
@@ -0,0 +1,160 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	vellumclient "terraform-provider-vellum/internal/sdk/client"
+)
+
+func DataSource() datasource.DataSource {
+	return &DeploymentDataSource{}
+}
+
+type DeploymentDataSource struct {
+	client *vellumclient.Client
+}
+
+var _ datasource.DataSource = &DeploymentDataSource{}
+var _ datasource.DataSourceWithConfigure = &DeploymentDataSource{}
+
+type TfDeploymentDataSourceModel struct {
+	Created               types.String `tfsdk:"created"`
+	Description           types.String `tfsdk:"description"`
+	Environment           types.String `tfsdk:"environment"`
+	Id                    types.String `tfsdk:"id"`
+	Label                 types.String `tfsdk:"label"`
+	Name                  types.String `tfsdk:"name"`
+	Status                types.String `tfsdk:"status"`
+	LastDeployedOn        types.String `tfsdk:"last_deployed_on"`
+	ActiveReleaseId       types.String `tfsdk:"active_release_id"`
+	ActiveModelVersionIds types.List   `tfsdk:"active_model_version_ids"`
+}
+
+func (d *DeploymentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment"
+}
+
+func (d *DeploymentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Prompt Deployment data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The Prompt Deployment's ID. Exactly one of `id` or `name` must be set.",
+				MarkdownDescription: "The Prompt Deployment's ID. Exactly one of `id` or `name` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("id"),
+						path.MatchRoot("name"),
+					),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "A name that uniquely identifies this Prompt Deployment within its workspace",
+				MarkdownDescription: "A name that uniquely identifies this Prompt Deployment within its workspace",
+			},
+			"created": schema.StringAttribute{
+				Computed: true,
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				Description:         "A human-readable description of the Prompt Deployment",
+				MarkdownDescription: "A human-readable description of the Prompt Deployment",
+			},
+			"environment": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The environment this Prompt Deployment is used in\n\n* `DEVELOPMENT` - Development\n* `STAGING` - Staging\n* `PRODUCTION` - Production",
+				MarkdownDescription: "The environment this Prompt Deployment is used in\n\n* `DEVELOPMENT` - Development\n* `STAGING` - Staging\n* `PRODUCTION` - Production",
+			},
+			"label": schema.StringAttribute{
+				Computed:            true,
+				Description:         "A human-readable label for the Prompt Deployment",
+				MarkdownDescription: "A human-readable label for the Prompt Deployment",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The current status of the Prompt Deployment\n\n* `ACTIVE` - Active\n* `ARCHIVED` - Archived",
+				MarkdownDescription: "The current status of the Prompt Deployment\n\n* `ACTIVE` - Active\n* `ARCHIVED` - Archived",
+			},
+			"last_deployed_on": schema.StringAttribute{
+				Computed:            true,
+				Description:         "When the active release was deployed",
+				MarkdownDescription: "When the active release was deployed",
+			},
+			"active_release_id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The ID of the release the Prompt Deployment currently serves",
+				MarkdownDescription: "The ID of the release the Prompt Deployment currently serves",
+			},
+			"active_model_version_ids": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				Description:         "The IDs of the ML Model versions the active release runs against",
+				MarkdownDescription: "The IDs of the ML Model versions the active release runs against",
+			},
+		},
+	}
+}
+
+func (d *DeploymentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*vellumclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DeploymentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var deploymentModel *TfDeploymentDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &deploymentModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deploymentRetrieveParameter := deploymentModel.Name.ValueString()
+	if deploymentRetrieveParameter == "" {
+		deploymentRetrieveParameter = deploymentModel.Id.ValueString()
+	}
+
+	deployment, err := d.client.Deployments.Retrieve(ctx, deploymentRetrieveParameter)
+	if err != nil {
+		resp.Diagnostics.AddError("error getting Prompt Deployment information", err.Error())
+		return
+	}
+
+	deploymentModel, diagnostic := NewTfDeploymentDataSourceModel(ctx, deployment)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &deploymentModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
@@ -0,0 +1,136 @@
+package deployment
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	vellum "terraform-provider-vellum/internal/sdk"
+)
+
+func NewVellumDeploymentCreateRequest(ctx context.Context, deploymentModel *TfDeploymentResourceModel) (*vellum.DeploymentCreateRequest, diag.Diagnostics) {
+	status, environment, diags := newVellumDeploymentEnums(deploymentModel)
+
+	request := vellum.DeploymentCreateRequest{
+		Label:       deploymentModel.Label.ValueString(),
+		Name:        deploymentModel.Name.ValueString(),
+		Description: deploymentModel.Description.ValueStringPointer(),
+		Status:      status,
+		Environment: environment,
+	}
+
+	return &request, diags
+}
+
+func NewVellumDeploymentUpdateRequest(ctx context.Context, deploymentModel *TfDeploymentResourceModel) (*vellum.PatchedDeploymentUpdateRequest, diag.Diagnostics) {
+	status, environment, diags := newVellumDeploymentEnums(deploymentModel)
+
+	// An empty description clears the one set previously.
+	description := deploymentModel.Description.ValueString()
+	label := deploymentModel.Label.ValueString()
+
+	request := vellum.PatchedDeploymentUpdateRequest{
+		Label:       &label,
+		Description: &description,
+		Status:      status,
+		Environment: environment,
+	}
+
+	return &request, diags
+}
+
+func newVellumDeploymentEnums(deploymentModel *TfDeploymentResourceModel) (*vellum.EntityStatus, *vellum.EnvironmentEnum, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var status *vellum.EntityStatus
+	if deploymentModel.Status.ValueString() != "" {
+		s, err := vellum.NewEntityStatusFromString(deploymentModel.Status.ValueString())
+		if err != nil {
+			diags.AddError("invalid Prompt Deployment status", err.Error())
+		}
+		status = &s
+	}
+
+	var environment *vellum.EnvironmentEnum
+	if deploymentModel.Environment.ValueString() != "" {
+		env, err := vellum.NewEnvironmentEnumFromString(deploymentModel.Environment.ValueString())
+		if err != nil {
+			diags.AddError("invalid Prompt Deployment environment", err.Error())
+		}
+		environment = &env
+	}
+
+	return status, environment, diags
+}
+
+func NewTfDeploymentModel(ctx context.Context, model *TfDeploymentResourceModel, deployment *vellum.DeploymentRead) (*TfDeploymentResourceModel, diag.Diagnostics) {
+	deploymentModel := &TfDeploymentResourceModel{
+		Id:                    types.StringValue(deployment.Id),
+		FolderId:              model.FolderId,
+		Name:                  types.StringValue(deployment.Name),
+		Created:               types.StringValue(deployment.Created.String()),
+		Description:           newTfDescription(model.Description, deployment.Description),
+		Environment:           types.StringNull(),
+		Label:                 types.StringValue(deployment.Label),
+		Status:                types.StringNull(),
+		LastDeployedOn:        types.StringNull(),
+		ActiveReleaseId:       types.StringPointerValue(deployment.LastDeployedHistoryItemId),
+		ActiveModelVersionIds: newTfStringList(deployment.ActiveModelVersionIds),
+		Timeouts:              model.Timeouts,
+	}
+
+	if deployment.Environment != nil {
+		deploymentModel.Environment = types.StringValue(string(*deployment.Environment))
+	}
+	if deployment.Status != nil {
+		deploymentModel.Status = types.StringValue(string(*deployment.Status))
+	}
+	if deployment.LastDeployedOn != nil {
+		deploymentModel.LastDeployedOn = types.StringValue(deployment.LastDeployedOn.String())
+	}
+
+	return deploymentModel, nil
+}
+
+func NewTfDeploymentDataSourceModel(ctx context.Context, deployment *vellum.DeploymentRead) (*TfDeploymentDataSourceModel, diag.Diagnostics) {
+	deploymentModel, diags := NewTfDeploymentModel(ctx, &TfDeploymentResourceModel{}, deployment)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	dataSourceModel := &TfDeploymentDataSourceModel{
+		Created:               deploymentModel.Created,
+		Description:           deploymentModel.Description,
+		Environment:           deploymentModel.Environment,
+		Id:                    deploymentModel.Id,
+		Label:                 deploymentModel.Label,
+		Name:                  deploymentModel.Name,
+		Status:                deploymentModel.Status,
+		LastDeployedOn:        deploymentModel.LastDeployedOn,
+		ActiveReleaseId:       deploymentModel.ActiveReleaseId,
+		ActiveModelVersionIds: deploymentModel.ActiveModelVersionIds,
+	}
+	return dataSourceModel, diags
+}
+
+// newTfDescription keeps an unset description null, since Vellum reports a
+// missing description as an empty string.
+func newTfDescription(configured types.String, description *string) types.String {
+	if description == nil || *description == "" {
+		if configured.IsNull() {
+			return types.StringNull()
+		}
+		return types.StringValue("")
+	}
+	return types.StringValue(*description)
+}
+
+func newTfStringList(values []string) types.List {
+	elements := []attr.Value{}
+	for _, value := range values {
+		elements = append(elements, types.StringValue(value))
+	}
+	return types.ListValueMust(types.StringType, elements)
+}
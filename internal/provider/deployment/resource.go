@@ -0,0 +1,361 @@
+package deployment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-vellum/internal/provider/folder"
+	"terraform-provider-vellum/internal/provider/idempotency"
+	"terraform-provider-vellum/internal/provider/timeout"
+	vellum "terraform-provider-vellum/internal/sdk"
+	vellumclient "terraform-provider-vellum/internal/sdk/client"
+	"terraform-provider-vellum/internal/sdk/core"
+)
+
+var _ resource.ResourceWithConfigure = &DeploymentResource{}
+var _ resource.ResourceWithImportState = &DeploymentResource{}
+
+type DeploymentResource struct {
+	client *vellumclient.Client
+}
+
+func Resource() resource.Resource {
+	return &DeploymentResource{}
+}
+
+type TfDeploymentResourceModel struct {
+	Created               types.String   `tfsdk:"created"`
+	Description           types.String   `tfsdk:"description"`
+	Environment           types.String   `tfsdk:"environment"`
+	FolderId              types.String   `tfsdk:"folder_id"`
+	Id                    types.String   `tfsdk:"id"`
+	Label                 types.String   `tfsdk:"label"`
+	Name                  types.String   `tfsdk:"name"`
+	Status                types.String   `tfsdk:"status"`
+	LastDeployedOn        types.String   `tfsdk:"last_deployed_on"`
+	ActiveReleaseId       types.String   `tfsdk:"active_release_id"`
+	ActiveModelVersionIds types.List     `tfsdk:"active_model_version_ids"`
+	Timeouts              timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *DeploymentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment"
+}
+
+func (r *DeploymentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Prompt Deployment resource. Existing Prompt Deployments can be imported by ID or by name.",
+
+		Attributes: map[string]schema.Attribute{
+			"created": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Description:         "A human-readable description of the Prompt Deployment",
+				MarkdownDescription: "A human-readable description of the Prompt Deployment",
+			},
+			"environment": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The environment this Prompt Deployment is used in\n\n* `DEVELOPMENT` - Development\n* `STAGING` - Staging\n* `PRODUCTION` - Production",
+				MarkdownDescription: "The environment this Prompt Deployment is used in\n\n* `DEVELOPMENT` - Development\n* `STAGING` - Staging\n* `PRODUCTION` - Production",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"DEVELOPMENT",
+						"STAGING",
+						"PRODUCTION",
+					),
+				},
+			},
+			"folder_id": folder.EntityFolderIdAttribute("Deployment"),
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The Prompt Deployment's ID",
+				MarkdownDescription: "The Prompt Deployment's ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Required:            true,
+				Description:         "A human-readable label for the Prompt Deployment",
+				MarkdownDescription: "A human-readable label for the Prompt Deployment",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 150),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "A name that uniquely identifies this Prompt Deployment within its workspace",
+				MarkdownDescription: "A name that uniquely identifies this Prompt Deployment within its workspace",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 150),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"status": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The current status of the Prompt Deployment\n\n* `ACTIVE` - Active\n* `ARCHIVED` - Archived",
+				MarkdownDescription: "The current status of the Prompt Deployment\n\n* `ACTIVE` - Active\n* `ARCHIVED` - Archived",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"ACTIVE",
+						"ARCHIVED",
+					),
+				},
+			},
+			"last_deployed_on": schema.StringAttribute{
+				Computed:            true,
+				Description:         "When the active release was deployed",
+				MarkdownDescription: "When the active release was deployed",
+			},
+			"active_release_id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The ID of the release the Prompt Deployment currently serves",
+				MarkdownDescription: "The ID of the release the Prompt Deployment currently serves",
+			},
+			"active_model_version_ids": schema.ListAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				Description:         "The IDs of the ML Model versions the active release runs against",
+				MarkdownDescription: "The IDs of the ML Model versions the active release runs against",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeout.Block(ctx),
+		},
+	}
+}
+
+func (r *DeploymentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*vellumclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DeploymentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var deploymentPlan *TfDeploymentResourceModel
+
+	diags := req.Plan.Get(ctx, &deploymentPlan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.WithResource(ctx, "vellum_deployment "+deploymentPlan.Name.ValueString())
+
+	ctx, deadline, diags := timeout.Start(ctx, deploymentPlan.Timeouts, timeout.Create, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	deploymentRequest, d := NewVellumDeploymentCreateRequest(ctx, deploymentPlan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployment, err := r.client.Deployments.Create(idempotency.Context(ctx, "vellum_deployment", deploymentRequest), deploymentRequest)
+	if idempotency.IsConflict(err) {
+		deployment, err = r.adopt(ctx, deploymentRequest, err)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create deployment, got error: %s", err))
+		return
+	}
+
+	if err := folder.MoveEntity(ctx, r.client, deployment.Id, deploymentPlan.FolderId, types.StringNull(), folder.DeploymentRootFolderId); err != nil {
+		// The Deployment exists, so keep it in state outside of any Folder.
+		deploymentPlan.FolderId = types.StringNull()
+		deploymentModel, _ := NewTfDeploymentModel(ctx, deploymentPlan, deployment)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &deploymentModel)...)
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to move deployment into folder, got error: %s", err))
+		return
+	}
+
+	deploymentModel, diagnostic := NewTfDeploymentModel(ctx, deploymentPlan, deployment)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &deploymentModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// adopt returns the Deployment already holding the planned name, such as one
+// whose create succeeded without reaching the state, when it matches the
+// plan. Otherwise it returns why it can't be adopted, or createErr when it
+// can't be looked up.
+func (r *DeploymentResource) adopt(ctx context.Context, request *vellum.DeploymentCreateRequest, createErr error) (*vellum.DeploymentRead, error) {
+	deployment, err := r.client.Deployments.Retrieve(ctx, request.Name)
+	if err != nil {
+		return nil, createErr
+	}
+
+	var mismatches idempotency.Mismatches
+	mismatches.Compare("label", &request.Label, &deployment.Label)
+	mismatches.Compare("description", request.Description, deployment.Description)
+	mismatches.Compare("environment", (*string)(request.Environment), (*string)(deployment.Environment))
+	mismatches.Compare("status", (*string)(request.Status), (*string)(deployment.Status))
+	if err := mismatches.Err("vellum_deployment", request.Name, deployment.Id); err != nil {
+		return nil, err
+	}
+
+	tflog.Info(ctx, "adopted existing deployment", map[string]interface{}{
+		"id":   deployment.Id,
+		"name": deployment.Name,
+	})
+	return deployment, nil
+}
+
+func (r *DeploymentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var deploymentState TfDeploymentResourceModel
+	var err error
+	resp.Diagnostics.Append(req.State.Get(ctx, &deploymentState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, deadline, diags := timeout.Start(ctx, deploymentState.Timeouts, timeout.Read, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	// Imports may key off of the Prompt Deployment's name, which Retrieve
+	// accepts in place of its ID.
+	deployment, err := r.client.Deployments.Retrieve(ctx, deploymentState.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read deployment, got error: %s", err))
+		return
+	}
+
+	deploymentModel, diagnostic := NewTfDeploymentModel(ctx, &deploymentState, deployment)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &deploymentModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DeploymentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var deploymentPlan *TfDeploymentResourceModel
+	var deploymentState *TfDeploymentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &deploymentPlan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &deploymentState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.WithResource(ctx, "vellum_deployment "+deploymentPlan.Name.ValueString())
+
+	ctx, deadline, diags := timeout.Start(ctx, deploymentPlan.Timeouts, timeout.Update, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	deploymentRequest, d := NewVellumDeploymentUpdateRequest(ctx, deploymentPlan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deployment, err := r.client.Deployments.PartialUpdate(ctx, deploymentState.Id.ValueString(), deploymentRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("error during deployment update", err.Error())
+		return
+	}
+
+	if err := folder.MoveEntity(ctx, r.client, deployment.Id, deploymentPlan.FolderId, deploymentState.FolderId, folder.DeploymentRootFolderId); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to move deployment into folder, got error: %s", err))
+		return
+	}
+
+	deploymentModel, diagnostic := NewTfDeploymentModel(ctx, deploymentPlan, deployment)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &deploymentModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DeploymentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var deploymentState *TfDeploymentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &deploymentState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.WithResource(ctx, "vellum_deployment "+deploymentState.Name.ValueString())
+
+	ctx, deadline, diags := timeout.Start(ctx, deploymentState.Timeouts, timeout.Delete, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	err := r.client.Deployments.Destroy(
+		ctx,
+		deploymentState.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("error when destroying the deployment resource", err.Error())
+		return
+	}
+}
+
+func (r *DeploymentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
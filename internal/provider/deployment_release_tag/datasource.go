@@ -0,0 +1,174 @@
+package deployment_release_tag
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	vellum "terraform-provider-vellum/internal/sdk"
+	vellumclient "terraform-provider-vellum/internal/sdk/client"
+)
+
+func DataSource() datasource.DataSource {
+	return &DeploymentReleaseTagDataSource{}
+}
+
+type DeploymentReleaseTagDataSource struct {
+	client *vellumclient.Client
+}
+
+var _ datasource.DataSource = &DeploymentReleaseTagDataSource{}
+var _ datasource.DataSourceWithConfigure = &DeploymentReleaseTagDataSource{}
+
+type TfDeploymentReleaseTagDataSourceModel struct {
+	DeploymentId types.String         `tfsdk:"deployment_id"`
+	Name         types.String         `tfsdk:"name"`
+	ReleaseId    types.String         `tfsdk:"release_id"`
+	Source       types.String         `tfsdk:"source"`
+	ReleasedAt   types.String         `tfsdk:"released_at"`
+	Release      *TfDeploymentRelease `tfsdk:"release"`
+}
+
+type TfDeploymentRelease struct {
+	Id             types.String `tfsdk:"id"`
+	Timestamp      types.String `tfsdk:"timestamp"`
+	Label          types.String `tfsdk:"label"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	InputVariables types.List   `tfsdk:"input_variables"`
+}
+
+func (d *DeploymentReleaseTagDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_release_tag"
+}
+
+func (d *DeploymentReleaseTagDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Prompt Deployment Release Tag data source. Resolves a Release Tag to the release it currently points at.",
+
+		Attributes: map[string]schema.Attribute{
+			"deployment_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Prompt Deployment the Release Tag belongs to",
+				MarkdownDescription: "The ID of the Prompt Deployment the Release Tag belongs to",
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The name of the Release Tag, e.g. `LATEST`, `stable` or `canary`",
+				MarkdownDescription: "The name of the Release Tag, e.g. `LATEST`, `stable` or `canary`",
+			},
+			"release_id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The ID of the Prompt Deployment release the Release Tag points at",
+				MarkdownDescription: "The ID of the Prompt Deployment release the Release Tag points at",
+			},
+			"source": schema.StringAttribute{
+				Computed:            true,
+				Description:         "How the Release Tag was originally created\n\n* `SYSTEM` - System\n* `USER` - User",
+				MarkdownDescription: "How the Release Tag was originally created\n\n* `SYSTEM` - System\n* `USER` - User",
+			},
+			"released_at": schema.StringAttribute{
+				Computed:            true,
+				Description:         "When the release the Release Tag points at was created",
+				MarkdownDescription: "When the release the Release Tag points at was created",
+			},
+			"release": schema.SingleNestedAttribute{
+				Computed:            true,
+				Description:         "The history item recording the Prompt Deployment as of the release the Release Tag points at",
+				MarkdownDescription: "The history item recording the Prompt Deployment as of the release the Release Tag points at",
+				Attributes: map[string]schema.Attribute{
+					"id": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The release's ID",
+						MarkdownDescription: "The release's ID",
+					},
+					"timestamp": schema.StringAttribute{
+						Computed:            true,
+						Description:         "When the release was created",
+						MarkdownDescription: "When the release was created",
+					},
+					"label": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The Prompt Deployment's label at the time of the release",
+						MarkdownDescription: "The Prompt Deployment's label at the time of the release",
+					},
+					"name": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The Prompt Deployment's name at the time of the release",
+						MarkdownDescription: "The Prompt Deployment's name at the time of the release",
+					},
+					"description": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The Prompt Deployment's description at the time of the release",
+						MarkdownDescription: "The Prompt Deployment's description at the time of the release",
+					},
+					"input_variables": schema.ListAttribute{
+						Computed:            true,
+						ElementType:         types.StringType,
+						Description:         "The keys of the input variables the release expects",
+						MarkdownDescription: "The keys of the input variables the release expects",
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DeploymentReleaseTagDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*vellumclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DeploymentReleaseTagDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var releaseTagModel *TfDeploymentReleaseTagDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &releaseTagModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deploymentId := releaseTagModel.DeploymentId.ValueString()
+	releaseTag, err := d.client.Deployments.RetrieveDeploymentReleaseTag(ctx, deploymentId, releaseTagModel.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("error getting Prompt Deployment Release Tag information", err.Error())
+		return
+	}
+
+	var historyItem *vellum.DeploymentHistoryItem
+	if releaseTag.HistoryItem != nil {
+		historyItem, err = d.client.Deployments.DeploymentHistoryItemRetrieve(ctx, releaseTag.HistoryItem.Id, deploymentId)
+		if err != nil {
+			resp.Diagnostics.AddError("error getting Prompt Deployment release information", err.Error())
+			return
+		}
+	}
+
+	releaseTagModel, diagnostic := NewTfDeploymentReleaseTagDataSourceModel(ctx, releaseTagModel, releaseTag, historyItem)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &releaseTagModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
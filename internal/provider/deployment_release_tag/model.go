@@ -0,0 +1,63 @@
+package deployment_release_tag
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	vellum "terraform-provider-vellum/internal/sdk"
+)
+
+func NewTfDeploymentReleaseTagModel(ctx context.Context, model *TfDeploymentReleaseTagResourceModel, releaseTag *vellum.DeploymentReleaseTagRead) (*TfDeploymentReleaseTagResourceModel, diag.Diagnostics) {
+	releaseTagModel := &TfDeploymentReleaseTagResourceModel{
+		Id:           types.StringValue(model.DeploymentId.ValueString() + "/" + releaseTag.Name),
+		DeploymentId: model.DeploymentId,
+		Name:         types.StringValue(releaseTag.Name),
+		ReleaseId:    types.StringNull(),
+		Source:       types.StringValue(string(releaseTag.Source)),
+		ReleasedAt:   types.StringNull(),
+		Timeouts:     model.Timeouts,
+	}
+
+	if releaseTag.HistoryItem != nil {
+		releaseTagModel.ReleaseId = types.StringValue(releaseTag.HistoryItem.Id)
+		releaseTagModel.ReleasedAt = types.StringValue(releaseTag.HistoryItem.Timestamp.String())
+	}
+
+	return releaseTagModel, nil
+}
+
+func NewTfDeploymentReleaseTagDataSourceModel(ctx context.Context, model *TfDeploymentReleaseTagDataSourceModel, releaseTag *vellum.DeploymentReleaseTagRead, historyItem *vellum.DeploymentHistoryItem) (*TfDeploymentReleaseTagDataSourceModel, diag.Diagnostics) {
+	releaseTagModel := &TfDeploymentReleaseTagDataSourceModel{
+		DeploymentId: model.DeploymentId,
+		Name:         types.StringValue(releaseTag.Name),
+		ReleaseId:    types.StringNull(),
+		Source:       types.StringValue(string(releaseTag.Source)),
+		ReleasedAt:   types.StringNull(),
+	}
+
+	if releaseTag.HistoryItem != nil {
+		releaseTagModel.ReleaseId = types.StringValue(releaseTag.HistoryItem.Id)
+		releaseTagModel.ReleasedAt = types.StringValue(releaseTag.HistoryItem.Timestamp.String())
+	}
+
+	if historyItem != nil {
+		inputVariables := []attr.Value{}
+		for _, inputVariable := range historyItem.InputVariables {
+			inputVariables = append(inputVariables, types.StringValue(inputVariable.Key))
+		}
+
+		releaseTagModel.Release = &TfDeploymentRelease{
+			Id:             types.StringValue(historyItem.Id),
+			Timestamp:      types.StringValue(historyItem.Timestamp.String()),
+			Label:          types.StringValue(historyItem.Label),
+			Name:           types.StringValue(historyItem.Name),
+			Description:    types.StringPointerValue(historyItem.Description),
+			InputVariables: types.ListValueMust(types.StringType, inputVariables),
+		}
+	}
+
+	return releaseTagModel, nil
+}
@@ -0,0 +1,291 @@
+package deployment_release_tag
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-vellum/internal/provider/timeout"
+	vellum "terraform-provider-vellum/internal/sdk"
+	vellumclient "terraform-provider-vellum/internal/sdk/client"
+	"terraform-provider-vellum/internal/sdk/core"
+)
+
+var _ resource.ResourceWithConfigure = &DeploymentReleaseTagResource{}
+var _ resource.ResourceWithImportState = &DeploymentReleaseTagResource{}
+
+type DeploymentReleaseTagResource struct {
+	client *vellumclient.Client
+}
+
+func Resource() resource.Resource {
+	return &DeploymentReleaseTagResource{}
+}
+
+type TfDeploymentReleaseTagResourceModel struct {
+	Id           types.String   `tfsdk:"id"`
+	DeploymentId types.String   `tfsdk:"deployment_id"`
+	Name         types.String   `tfsdk:"name"`
+	ReleaseId    types.String   `tfsdk:"release_id"`
+	Source       types.String   `tfsdk:"source"`
+	ReleasedAt   types.String   `tfsdk:"released_at"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *DeploymentReleaseTagResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deployment_release_tag"
+}
+
+func (r *DeploymentReleaseTagResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Prompt Deployment Release Tag resource. Pins a Release Tag of a Prompt Deployment to a specific release. " +
+			"Vellum has no way to delete a Release Tag, so destroying this resource leaves the tag pointing at its last release. " +
+			"Import it with `<deployment_id>/<name>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The Release Tag's ID, in the form `<deployment_id>/<name>`",
+				MarkdownDescription: "The Release Tag's ID, in the form `<deployment_id>/<name>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deployment_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Prompt Deployment the Release Tag belongs to",
+				MarkdownDescription: "The ID of the Prompt Deployment the Release Tag belongs to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The name of the Release Tag, e.g. `LATEST`, `stable` or `canary`",
+				MarkdownDescription: "The name of the Release Tag, e.g. `LATEST`, `stable` or `canary`",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"release_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Prompt Deployment release the Release Tag points at",
+				MarkdownDescription: "The ID of the Prompt Deployment release the Release Tag points at",
+			},
+			"source": schema.StringAttribute{
+				Computed:            true,
+				Description:         "How the Release Tag was originally created\n\n* `SYSTEM` - System\n* `USER` - User",
+				MarkdownDescription: "How the Release Tag was originally created\n\n* `SYSTEM` - System\n* `USER` - User",
+			},
+			"released_at": schema.StringAttribute{
+				Computed:            true,
+				Description:         "When the release the Release Tag points at was created",
+				MarkdownDescription: "When the release the Release Tag points at was created",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeout.Block(ctx),
+		},
+	}
+}
+
+func (r *DeploymentReleaseTagResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*vellumclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DeploymentReleaseTagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var releaseTagPlan *TfDeploymentReleaseTagResourceModel
+
+	diags := req.Plan.Get(ctx, &releaseTagPlan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.WithResource(ctx, "vellum_deployment_release_tag "+releaseTagPlan.Name.ValueString())
+
+	ctx, deadline, diags := timeout.Start(ctx, releaseTagPlan.Timeouts, timeout.Create, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	releaseTag, err := r.client.Deployments.UpdateDeploymentReleaseTag(ctx,
+		releaseTagPlan.DeploymentId.ValueString(),
+		releaseTagPlan.Name.ValueString(),
+		&vellum.PatchedDeploymentReleaseTagUpdateRequest{
+			HistoryItemId: releaseTagPlan.ReleaseId.ValueStringPointer(),
+		})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to tag deployment release, got error: %s", err))
+		return
+	}
+
+	releaseTagModel, diagnostic := NewTfDeploymentReleaseTagModel(ctx, releaseTagPlan, releaseTag)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &releaseTagModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DeploymentReleaseTagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var releaseTagState TfDeploymentReleaseTagResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &releaseTagState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, deadline, diags := timeout.Start(ctx, releaseTagState.Timeouts, timeout.Read, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	releaseTag, err := r.client.Deployments.RetrieveDeploymentReleaseTag(ctx,
+		releaseTagState.DeploymentId.ValueString(),
+		releaseTagState.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read deployment release tag, got error: %s", err))
+		return
+	}
+
+	releaseTagModel, diagnostic := NewTfDeploymentReleaseTagModel(ctx, &releaseTagState, releaseTag)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Someone retagged outside of Terraform, e.g. in the UI. Refreshing
+	// release_id surfaces it as drift, and the next apply moves it back.
+	if !releaseTagState.ReleaseId.IsNull() && !releaseTagState.ReleaseId.Equal(releaseTagModel.ReleaseId) {
+		tflog.Warn(ctx, "deployment release tag was moved outside of Terraform", map[string]interface{}{
+			"deployment_id":     releaseTagState.DeploymentId.ValueString(),
+			"name":              releaseTagState.Name.ValueString(),
+			"state_release_id":  releaseTagState.ReleaseId.ValueString(),
+			"remote_release_id": releaseTagModel.ReleaseId.ValueString(),
+		})
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &releaseTagModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DeploymentReleaseTagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var releaseTagPlan *TfDeploymentReleaseTagResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &releaseTagPlan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.WithResource(ctx, "vellum_deployment_release_tag "+releaseTagPlan.Name.ValueString())
+
+	ctx, deadline, diags := timeout.Start(ctx, releaseTagPlan.Timeouts, timeout.Update, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	releaseTag, err := r.client.Deployments.UpdateDeploymentReleaseTag(ctx,
+		releaseTagPlan.DeploymentId.ValueString(),
+		releaseTagPlan.Name.ValueString(),
+		&vellum.PatchedDeploymentReleaseTagUpdateRequest{
+			HistoryItemId: releaseTagPlan.ReleaseId.ValueStringPointer(),
+		})
+	if err != nil {
+		resp.Diagnostics.AddError("error during deployment release tag update", err.Error())
+		return
+	}
+
+	releaseTagModel, diagnostic := NewTfDeploymentReleaseTagModel(ctx, releaseTagPlan, releaseTag)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &releaseTagModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DeploymentReleaseTagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var releaseTagState *TfDeploymentReleaseTagResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &releaseTagState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.WithResource(ctx, "vellum_deployment_release_tag "+releaseTagState.Name.ValueString())
+
+	ctx, deadline, diags := timeout.Start(ctx, releaseTagState.Timeouts, timeout.Delete, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	resp.Diagnostics.AddWarning(
+		"Release Tag left in place",
+		fmt.Sprintf("Release Tags can't be deleted, so %q still points at release %s. It has only been removed from Terraform state.",
+			releaseTagState.Name.ValueString(), releaseTagState.ReleaseId.ValueString()),
+	)
+}
+
+func (r *DeploymentReleaseTagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	deploymentId, name, ok := strings.Cut(req.ID, "/")
+	if !ok || deploymentId == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier of the form <deployment_id>/<name>, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("deployment_id"), deploymentId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
@@ -0,0 +1,162 @@
+package document
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	vellum "terraform-provider-vellum/internal/sdk"
+)
+
+// inlineContentFilename is the filename inline `content` is uploaded under,
+// which tells Vellum to process it as plain text.
+const inlineContentFilename = "content.txt"
+
+func NewVellumUploadDocumentRequest(ctx context.Context, documentModel *TfDocumentResourceModel, documentIndexNames []string) (*vellum.UploadDocumentBodyRequest, diag.Diagnostics) {
+	request := vellum.UploadDocumentBodyRequest{
+		AddToIndexNames: documentIndexNames,
+		ExternalId:      documentModel.ExternalId.ValueStringPointer(),
+		Label:           documentModel.Label.ValueString(),
+		Metadata:        newVellumDocumentMetadata(documentModel.Metadata),
+	}
+
+	return &request, nil
+}
+
+func NewTfDocumentModel(ctx context.Context, model *TfDocumentResourceModel, document *vellum.DocumentRead) (*TfDocumentResourceModel, diag.Diagnostics) {
+	documentModel := &TfDocumentResourceModel{
+		Id:              types.StringValue(document.Id),
+		Label:           types.StringValue(document.Label),
+		ExternalId:      types.StringPointerValue(document.ExternalId),
+		SourcePath:      model.SourcePath,
+		Content:         model.Content,
+		ContentHash:     model.ContentHash,
+		Metadata:        model.Metadata,
+		ProcessingState: types.StringNull(),
+		LastUploadedAt:  types.StringValue(document.LastUploadedAt.String()),
+		// Imported Documents have no wait_for_processing yet.
+		WaitForProcessing: types.BoolValue(model.WaitForProcessing.IsNull() || model.WaitForProcessing.ValueBool()),
+		Timeouts:          model.Timeouts,
+		DocumentIndexIds: types.SetValueMust(
+			types.StringType,
+			func() []attr.Value {
+				documentIndexIds := []attr.Value{}
+				for _, documentToDocumentIndex := range document.DocumentToDocumentIndexes {
+					documentIndexIds = append(documentIndexIds, types.StringValue(documentToDocumentIndex.DocumentIndexId))
+				}
+				return documentIndexIds
+			}(),
+		),
+	}
+
+	if document.ProcessingState != nil {
+		documentModel.ProcessingState = types.StringValue(string(*document.ProcessingState))
+	}
+
+	if len(document.Metadata) > 0 || !model.Metadata.IsNull() {
+		documentModel.Metadata = NewTfDocumentMetadata(document.Metadata, model.Metadata)
+	}
+
+	return documentModel, nil
+}
+
+// newVellumDocumentMetadata decodes every metadata value that is valid JSON,
+// passing the rest through as plain strings.
+func newVellumDocumentMetadata(tfMetadata types.Map) map[string]interface{} {
+	if tfMetadata.IsNull() || tfMetadata.IsUnknown() {
+		return nil
+	}
+	metadata := map[string]interface{}{}
+	for key, tfvalue := range tfMetadata.Elements() {
+		metadata[key] = decodeMetadataValue(tfvalue.(types.String).ValueString())
+	}
+	return metadata
+}
+
+// newVellumDocumentMetadataPatch returns the metadata to update a Document
+// with. Unset metadata is sent as an empty object, since leaving it out
+// would keep the Document's current metadata.
+func newVellumDocumentMetadataPatch(tfMetadata types.Map) *map[string]interface{} {
+	metadata := newVellumDocumentMetadata(tfMetadata)
+	if metadata == nil {
+		metadata = map[string]interface{}{}
+	}
+	return &metadata
+}
+
+// NewTfDocumentMetadata returns a Document's metadata as strings, JSON
+// encoding every value that isn't one. A configured value is kept as is
+// whenever it decodes to the Document's, so that its formatting, such as
+// `1.0` or `{"a": 1}`, doesn't show up as a change. Pass a null map when
+// nothing is configured.
+func NewTfDocumentMetadata(values map[string]interface{}, configured types.Map) types.Map {
+	configuredValues := configured.Elements()
+	elements := map[string]attr.Value{}
+	for key, value := range values {
+		if configuredValue, ok := configuredValues[key].(types.String); ok && !configuredValue.IsNull() && !configuredValue.IsUnknown() {
+			if reflect.DeepEqual(decodeMetadataValue(configuredValue.ValueString()), value) {
+				elements[key] = configuredValue
+				continue
+			}
+		}
+		if s, ok := value.(string); ok {
+			elements[key] = types.StringValue(s)
+			continue
+		}
+		bytes, err := json.Marshal(value)
+		if err != nil {
+			elements[key] = types.StringValue(fmt.Sprintf("%v", value))
+			continue
+		}
+		elements[key] = types.StringValue(string(bytes))
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+// decodeMetadataValue decodes a metadata value that is valid JSON, returning
+// any other value as is.
+func decodeMetadataValue(value string) interface{} {
+	var decoded interface{}
+	if err := json.Unmarshal([]byte(value), &decoded); err != nil {
+		return value
+	}
+	return decoded
+}
+
+// documentContents returns the contents to upload for the given Document,
+// along with the filename to upload them under.
+func documentContents(documentModel *TfDocumentResourceModel) ([]byte, string, error) {
+	if documentModel.SourcePath.IsNull() {
+		return []byte(documentModel.Content.ValueString()), inlineContentFilename, nil
+	}
+	sourcePath := documentModel.SourcePath.ValueString()
+	contents, err := os.ReadFile(sourcePath)
+	if err != nil {
+		return nil, "", err
+	}
+	return contents, filepath.Base(sourcePath), nil
+}
+
+func hashContents(contents []byte) string {
+	sum := sha256.Sum256(contents)
+	return hex.EncodeToString(sum[:])
+}
+
+func documentIndexIds(documentModel *TfDocumentResourceModel) []string {
+	var ids []string
+	for _, id := range documentModel.DocumentIndexIds.Elements() {
+		ids = append(ids, id.(types.String).ValueString())
+	}
+	sort.Strings(ids)
+	return ids
+}
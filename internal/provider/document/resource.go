@@ -0,0 +1,386 @@
+package document
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-vellum/internal/provider/timeout"
+	vellum "terraform-provider-vellum/internal/sdk"
+	vellumclient "terraform-provider-vellum/internal/sdk/client"
+	"terraform-provider-vellum/internal/sdk/core"
+)
+
+var _ resource.ResourceWithConfigure = &DocumentResource{}
+var _ resource.ResourceWithImportState = &DocumentResource{}
+var _ resource.ResourceWithModifyPlan = &DocumentResource{}
+
+type DocumentResource struct {
+	client *vellumclient.Client
+}
+
+func Resource() resource.Resource {
+	return &DocumentResource{}
+}
+
+type TfDocumentResourceModel struct {
+	Id                types.String   `tfsdk:"id"`
+	Label             types.String   `tfsdk:"label"`
+	ExternalId        types.String   `tfsdk:"external_id"`
+	SourcePath        types.String   `tfsdk:"source_path"`
+	Content           types.String   `tfsdk:"content"`
+	ContentHash       types.String   `tfsdk:"content_hash"`
+	Metadata          types.Map      `tfsdk:"metadata"`
+	DocumentIndexIds  types.Set      `tfsdk:"document_index_ids"`
+	ProcessingState   types.String   `tfsdk:"processing_state"`
+	LastUploadedAt    types.String   `tfsdk:"last_uploaded_at"`
+	WaitForProcessing types.Bool     `tfsdk:"wait_for_processing"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *DocumentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_document"
+}
+
+func (r *DocumentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Document resource. Uploads a document into one or more Document Indexes and re-uploads it whenever its contents change.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The Document's ID",
+				MarkdownDescription: "The Document's ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Required:            true,
+				Description:         "A human-readable label for the Document",
+				MarkdownDescription: "A human-readable label for the Document",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 1000),
+				},
+			},
+			"external_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The unique ID of this Document as it exists in your own system",
+				MarkdownDescription: "The unique ID of this Document as it exists in your own system",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"source_path": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Path to a local file to upload. Exactly one of `source_path` or `content` must be set.",
+				MarkdownDescription: "Path to a local file to upload. Exactly one of `source_path` or `content` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("source_path"),
+						path.MatchRoot("content"),
+					),
+				},
+			},
+			"content": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Inline contents to upload as a plain text file. Exactly one of `source_path` or `content` must be set.",
+				MarkdownDescription: "Inline contents to upload as a plain text file. Exactly one of `source_path` or `content` must be set.",
+			},
+			"content_hash": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The SHA-256 hash of the uploaded contents. The Document is re-uploaded when it changes.",
+				MarkdownDescription: "The SHA-256 hash of the uploaded contents. The Document is re-uploaded when it changes.",
+			},
+			"metadata": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "Metadata that can be filtered on when searching. Values that are valid JSON are decoded before being sent.",
+				MarkdownDescription: "Metadata that can be filtered on when searching. Values that are valid JSON are decoded before being sent.",
+			},
+			"document_index_ids": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				Description:         "The IDs of the Document Indexes this Document is included in",
+				MarkdownDescription: "The IDs of the Document Indexes this Document is included in",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"processing_state": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Where the Document is along its processing lifecycle\n\n* `QUEUED` - Queued\n* `PROCESSING` - Processing\n* `PROCESSED` - Processed\n* `FAILED` - Failed",
+				MarkdownDescription: "Where the Document is along its processing lifecycle\n\n* `QUEUED` - Queued\n* `PROCESSING` - Processing\n* `PROCESSED` - Processed\n* `FAILED` - Failed",
+			},
+			"last_uploaded_at": schema.StringAttribute{
+				Computed:            true,
+				Description:         "When the Document was most recently uploaded",
+				MarkdownDescription: "When the Document was most recently uploaded",
+			},
+			"wait_for_processing": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				Description:         "Whether to wait, up to the `create` timeout, for the Document to be indexed before it's marked as created. Defaults to true; disable it for very large corpora.",
+				MarkdownDescription: "Whether to wait, up to the `create` timeout, for the Document to be indexed before it's marked as created. Defaults to `true`; disable it for very large corpora.",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeout.Block(ctx),
+		},
+	}
+}
+
+func (r *DocumentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*vellumclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DocumentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var documentPlan *TfDocumentResourceModel
+	var documentState *TfDocumentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &documentPlan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &documentState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if documentPlan.SourcePath.IsUnknown() || documentPlan.Content.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_hash"), types.StringUnknown())...)
+		return
+	}
+
+	contents, _, err := documentContents(documentPlan)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source_path"), "failed to read Document contents", err.Error())
+		return
+	}
+	contentHash := hashContents(contents)
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_hash"), types.StringValue(contentHash))...)
+	// An imported Document has no hash yet. Its contents are trusted to
+	// match, and Update records the planned hash.
+	if documentState != nil && !documentState.ContentHash.IsNull() && documentState.ContentHash.ValueString() != contentHash {
+		resp.RequiresReplace = append(resp.RequiresReplace, path.Root("content_hash"))
+	}
+}
+
+func (r *DocumentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var documentPlan *TfDocumentResourceModel
+
+	diags := req.Plan.Get(ctx, &documentPlan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.WithResource(ctx, "vellum_document "+documentPlan.Label.ValueString())
+
+	ctx, deadline, diags := timeout.Start(ctx, documentPlan.Timeouts, timeout.Create, DefaultProcessingTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	contents, filename, err := documentContents(documentPlan)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("source_path"), "failed to read Document contents", err.Error())
+		return
+	}
+
+	var documentIndexNames []string
+	for _, documentIndexId := range documentIndexIds(documentPlan) {
+		documentIndex, err := r.client.DocumentIndexes.Retrieve(ctx, documentIndexId)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read document index %s, got error: %s", documentIndexId, err))
+			return
+		}
+		documentIndexNames = append(documentIndexNames, documentIndex.Name)
+	}
+
+	documentRequest, d := NewVellumUploadDocumentRequest(ctx, documentPlan, documentIndexNames)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	uploadResponse, err := r.client.Documents.Upload(ctx, bytes.NewReader(contents), filename, documentRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to upload document, got error: %s", err))
+		return
+	}
+
+	var document *vellum.DocumentRead
+	if documentPlan.WaitForProcessing.ValueBool() {
+		document, err = WaitForProcessing(ctx, r.client, uploadResponse.DocumentId)
+	} else {
+		document, err = r.client.Documents.Retrieve(ctx, uploadResponse.DocumentId)
+	}
+	if err != nil {
+		// The Document exists whatever went wrong, so keep track of it and
+		// let the next apply replace it.
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), uploadResponse.DocumentId)...)
+		resp.Diagnostics.AddError("Document Processing Error", fmt.Sprintf("Unable to process document %s, got error: %s", uploadResponse.DocumentId, err))
+		return
+	}
+
+	documentModel, diagnostic := NewTfDocumentModel(ctx, documentPlan, document)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	documentModel.ContentHash = types.StringValue(hashContents(contents))
+
+	// Save data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &documentModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DocumentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var documentState TfDocumentResourceModel
+	var err error
+	resp.Diagnostics.Append(req.State.Get(ctx, &documentState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, deadline, diags := timeout.Start(ctx, documentState.Timeouts, timeout.Read, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	document, err := r.client.Documents.Retrieve(ctx, documentState.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read document, got error: %s", err))
+		return
+	}
+
+	documentModel, diagnostic := NewTfDocumentModel(ctx, &documentState, document)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &documentModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DocumentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var documentPlan *TfDocumentResourceModel
+	var documentState *TfDocumentResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &documentPlan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &documentState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.WithResource(ctx, "vellum_document "+documentPlan.Label.ValueString())
+
+	ctx, deadline, diags := timeout.Start(ctx, documentPlan.Timeouts, timeout.Update, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	id := documentState.Id.ValueString()
+	label := documentPlan.Label.ValueString()
+
+	document, err := r.client.Documents.PartialUpdate(ctx,
+		id,
+		&vellum.PatchedDocumentUpdateRequest{
+			Label:    &label,
+			Metadata: newVellumDocumentMetadataPatch(documentPlan.Metadata),
+		})
+
+	if err != nil {
+		resp.Diagnostics.AddError("error during document update", err.Error())
+		return
+	}
+
+	documentModel, diagnostic := NewTfDocumentModel(ctx, documentPlan, document)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &documentModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DocumentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var documentState *TfDocumentResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &documentState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.WithResource(ctx, "vellum_document "+documentState.Label.ValueString())
+
+	ctx, deadline, diags := timeout.Start(ctx, documentState.Timeouts, timeout.Delete, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	err := r.client.Documents.Destroy(
+		ctx,
+		documentState.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("error when destroying the document resource", err.Error())
+		return
+	}
+}
+
+func (r *DocumentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,94 @@
+package document
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	vellum "terraform-provider-vellum/internal/sdk"
+	vellumclient "terraform-provider-vellum/internal/sdk/client"
+	"terraform-provider-vellum/internal/sdk/core"
+)
+
+const (
+	// DefaultProcessingTimeout is how long to wait for a Document to be
+	// processed when no `create` timeout is configured.
+	DefaultProcessingTimeout = 20 * time.Minute
+
+	processingPollMinInterval = time.Second
+	processingPollMaxInterval = 30 * time.Second
+)
+
+// WaitForProcessing polls the given Document with exponential backoff until
+// it is indexed into every Document Index it belongs to, processing fails,
+// or the context is done.
+func WaitForProcessing(ctx context.Context, client *vellumclient.Client, id string) (*vellum.DocumentRead, error) {
+	interval := processingPollMinInterval
+	for {
+		// Processing happens outside of the provider, so a cached Document
+		// would never progress.
+		document, err := client.Documents.Retrieve(core.WithoutCache(ctx), id)
+		if err != nil {
+			return nil, err
+		}
+
+		done, err := processingDone(document)
+		if err != nil || done {
+			return document, err
+		}
+
+		tflog.Debug(ctx, "waiting for document processing", map[string]interface{}{
+			"document_id":      id,
+			"processing_state": processingStateString(document),
+			"next_poll_in":     interval.String(),
+		})
+
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("timed out waiting for document %s to be processed, last seen in state %s: %w", id, processingStateString(document), ctx.Err())
+		case <-time.After(interval):
+		}
+
+		interval *= 2
+		if interval > processingPollMaxInterval {
+			interval = processingPollMaxInterval
+		}
+	}
+}
+
+// processingDone reports whether the Document has been indexed into every
+// Document Index it belongs to, returning an error if processing failed.
+func processingDone(document *vellum.DocumentRead) (bool, error) {
+	if document.ProcessingState != nil && *document.ProcessingState == vellum.ProcessingStateEnumFailed {
+		reason := "unknown reason"
+		if document.ProcessingFailureReason != nil {
+			reason = string(*document.ProcessingFailureReason)
+		}
+		return false, fmt.Errorf("document %s failed processing: %s", document.Id, reason)
+	}
+
+	for _, documentToDocumentIndex := range document.DocumentToDocumentIndexes {
+		if documentToDocumentIndex.IndexingState == nil {
+			return false, nil
+		}
+		switch *documentToDocumentIndex.IndexingState {
+		case vellum.IndexingStateEnumIndexed:
+			continue
+		case vellum.IndexingStateEnumFailed:
+			return false, fmt.Errorf("document %s failed indexing into document index %s", document.Id, documentToDocumentIndex.DocumentIndexId)
+		default:
+			return false, nil
+		}
+	}
+
+	return document.ProcessingState != nil && *document.ProcessingState == vellum.ProcessingStateEnumProcessed, nil
+}
+
+func processingStateString(document *vellum.DocumentRead) string {
+	if document.ProcessingState == nil {
+		return "UNKNOWN"
+	}
+	return string(*document.ProcessingState)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	vellum "terraform-provider-vellum/internal/sdk"
 	vellumclient "terraform-provider-vellum/internal/sdk/client"
 )
 
@@ -31,6 +32,28 @@ type TfDocumentIndexDataSourceModel struct {
 	Label       types.String `tfsdk:"label"`
 	Name        types.String `tfsdk:"name"`
 	Status      types.String `tfsdk:"status"`
+
+	IndexingConfig                *TfDocumentIndexIndexingConfig `tfsdk:"indexing_config"`
+	IndexingConfigJson            types.String                   `tfsdk:"indexing_config_json"`
+	DocumentCount                 types.Int64                    `tfsdk:"document_count"`
+	DocumentProcessingStateCounts types.Map                      `tfsdk:"document_processing_state_counts"`
+
+	CountDocumentProcessingStates types.Bool `tfsdk:"count_document_processing_states"`
+}
+
+type TfDocumentIndexIndexingConfig struct {
+	Vectorizer *TfDocumentIndexVectorizer `tfsdk:"vectorizer"`
+	Chunking   *TfDocumentIndexChunking   `tfsdk:"chunking"`
+}
+
+type TfDocumentIndexVectorizer struct {
+	ModelName types.String `tfsdk:"model_name"`
+	Config    types.Map    `tfsdk:"config"`
+}
+
+type TfDocumentIndexChunking struct {
+	ChunkerName   types.String `tfsdk:"chunker_name"`
+	ChunkerConfig types.Map    `tfsdk:"chunker_config"`
 }
 
 func (d *DocumentIndexDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -77,6 +100,70 @@ func (d *DocumentIndexDataSource) Schema(ctx context.Context, req datasource.Sch
 					),
 				},
 			},
+			"indexing_config": schema.SingleNestedAttribute{
+				Computed:            true,
+				Description:         "Configuration representing how documents are indexed",
+				MarkdownDescription: "Configuration representing how documents are indexed",
+				Attributes: map[string]schema.Attribute{
+					"vectorizer": schema.SingleNestedAttribute{
+						Computed:            true,
+						Description:         "The embedding model used to vectorize documents and queries",
+						MarkdownDescription: "The embedding model used to vectorize documents and queries",
+						Attributes: map[string]schema.Attribute{
+							"model_name": schema.StringAttribute{
+								Computed:            true,
+								Description:         "The name of the embedding model, e.g. `hkunlp/instructor-xl`",
+								MarkdownDescription: "The name of the embedding model, e.g. `hkunlp/instructor-xl`",
+							},
+							"config": schema.MapAttribute{
+								Computed:            true,
+								ElementType:         types.StringType,
+								Description:         "The embedding model's configuration. Non-string values are JSON-encoded.",
+								MarkdownDescription: "The embedding model's configuration. Non-string values are JSON-encoded.",
+							},
+						},
+					},
+					"chunking": schema.SingleNestedAttribute{
+						Computed:            true,
+						Description:         "How documents are split into chunks before being vectorized",
+						MarkdownDescription: "How documents are split into chunks before being vectorized",
+						Attributes: map[string]schema.Attribute{
+							"chunker_name": schema.StringAttribute{
+								Computed:            true,
+								Description:         "The name of the chunker, e.g. `sentence-chunker`",
+								MarkdownDescription: "The name of the chunker, e.g. `sentence-chunker`",
+							},
+							"chunker_config": schema.MapAttribute{
+								Computed:            true,
+								ElementType:         types.StringType,
+								Description:         "The chunker's configuration. Non-string values are JSON-encoded.",
+								MarkdownDescription: "The chunker's configuration. Non-string values are JSON-encoded.",
+							},
+						},
+					},
+				},
+			},
+			"indexing_config_json": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The raw indexing configuration, JSON-encoded",
+				MarkdownDescription: "The raw indexing configuration, JSON-encoded",
+			},
+			"document_count": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "The number of documents in the document index",
+				MarkdownDescription: "The number of documents in the document index",
+			},
+			"document_processing_state_counts": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				Description:         "The number of documents in each processing state, when count_document_processing_states is true\n\n* `QUEUED` - Queued\n* `PROCESSING` - Processing\n* `PROCESSED` - Processed\n* `FAILED` - Failed",
+				MarkdownDescription: "The number of documents in each processing state, when `count_document_processing_states` is `true`\n\n* `QUEUED` - Queued\n* `PROCESSING` - Processing\n* `PROCESSED` - Processed\n* `FAILED` - Failed",
+			},
+			"count_document_processing_states": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Whether to fill document_processing_state_counts. Counting lists every document in the document index on each read, so it's off by default.",
+				MarkdownDescription: "Whether to fill `document_processing_state_counts`. Counting lists every document in the document index on each read, so it's off by default.",
+			},
 		},
 	}
 }
@@ -124,11 +211,37 @@ func (d *DocumentIndexDataSource) Read(ctx context.Context, req datasource.ReadR
 		return
 	}
 
-	documentIndexModel, diagnostic := NewTfDocumentIndexDataSourceModel(ctx, documentIndex)
+	// Only the count of the first page is needed, which covers every page.
+	limit := 1
+	documentPage, err := d.client.Documents.List(ctx, &vellum.DocumentsListRequest{
+		DocumentIndexId: &documentIndex.Id,
+		Limit:           &limit,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("error listing Document Index documents", err.Error())
+		return
+	}
+
+	// The API can't count documents by processing state, so each of them
+	// has to be listed.
+	var documents []*vellum.SlimDocument
+	countDocumentProcessingStates := documentIndexModel.CountDocumentProcessingStates
+	if countDocumentProcessingStates.ValueBool() {
+		documents, err = d.client.Documents.ListAll(ctx, &vellum.DocumentsListRequest{
+			DocumentIndexId: &documentIndex.Id,
+		})
+		if err != nil {
+			resp.Diagnostics.AddError("error listing Document Index documents", err.Error())
+			return
+		}
+	}
+
+	documentIndexModel, diagnostic := NewTfDocumentIndexDataSourceModel(ctx, documentIndex, documentPage.Count, documents)
 	resp.Diagnostics.Append(diagnostic...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	documentIndexModel.CountDocumentProcessingStates = countDocumentProcessingStates
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &documentIndexModel)...)
 	if resp.Diagnostics.HasError() {
@@ -2,7 +2,10 @@ package document_index
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
@@ -41,25 +44,122 @@ func NewVellumDocumentIndexCreateRequest(ctx context.Context, documentIndexModel
 func NewTfDocumentIndexModel(ctx context.Context, model *TfDocumentIndexResourceModel, documentIndex *vellum.DocumentIndexRead) (*TfDocumentIndexResourceModel, diag.Diagnostics) {
 	documentIndexModel := &TfDocumentIndexResourceModel{
 		Id:          types.StringValue(documentIndex.Id),
+		FolderId:    model.FolderId,
 		Name:        types.StringValue(documentIndex.Name),
 		Created:     types.StringValue(documentIndex.Created.String()),
 		Environment: types.StringValue(string(*documentIndex.Environment)),
 		Label:       types.StringValue(documentIndex.Label),
 		Status:      types.StringValue(string(*documentIndex.Status)),
+		Timeouts:    model.Timeouts,
 	}
 
 	return documentIndexModel, nil
 }
 
-func NewTfDocumentIndexDataSourceModel(ctx context.Context, documentIndex *vellum.DocumentIndexRead) (*TfDocumentIndexDataSourceModel, diag.Diagnostics) {
+// NewTfDocumentIndexDataSourceModel returns the model of the given Document
+// Index, with its document count, and the counts of the given documents by
+// processing state unless they're nil.
+func NewTfDocumentIndexDataSourceModel(ctx context.Context, documentIndex *vellum.DocumentIndexRead, documentCount *int, documents []*vellum.SlimDocument) (*TfDocumentIndexDataSourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
 	documentIndexModel := &TfDocumentIndexDataSourceModel{
-		Id:          types.StringValue(documentIndex.Id),
-		Name:        types.StringValue(documentIndex.Name),
-		Created:     types.StringValue(documentIndex.Created.String()),
-		Environment: types.StringValue(string(*documentIndex.Environment)),
-		Label:       types.StringValue(documentIndex.Label),
-		Status:      types.StringValue(string(*documentIndex.Status)),
+		Id:                 types.StringValue(documentIndex.Id),
+		Name:               types.StringValue(documentIndex.Name),
+		Created:            types.StringValue(documentIndex.Created.String()),
+		Environment:        types.StringValue(string(*documentIndex.Environment)),
+		Label:              types.StringValue(documentIndex.Label),
+		Status:             types.StringValue(string(*documentIndex.Status)),
+		IndexingConfigJson: types.StringNull(),
+		DocumentCount:      types.Int64Null(),
+
+		DocumentProcessingStateCounts: types.MapNull(types.Int64Type),
+	}
+	if documentCount != nil {
+		documentIndexModel.DocumentCount = types.Int64Value(int64(*documentCount))
 	}
 
-	return documentIndexModel, nil
+	if documentIndex.IndexingConfig != nil {
+		indexingConfigJson, err := json.Marshal(documentIndex.IndexingConfig)
+		if err != nil {
+			diags.AddError("failed to encode Document Index indexing config", err.Error())
+			return nil, diags
+		}
+		documentIndexModel.IndexingConfigJson = types.StringValue(string(indexingConfigJson))
+		documentIndexModel.IndexingConfig = newTfDocumentIndexIndexingConfig(documentIndex.IndexingConfig)
+	}
+
+	if documents == nil {
+		return documentIndexModel, diags
+	}
+
+	processingStateCounts := map[string]int64{
+		string(vellum.ProcessingStateEnumQueued):     0,
+		string(vellum.ProcessingStateEnumProcessing): 0,
+		string(vellum.ProcessingStateEnumProcessed):  0,
+		string(vellum.ProcessingStateEnumFailed):     0,
+	}
+	for _, document := range documents {
+		if document.ProcessingState != nil {
+			processingStateCounts[string(*document.ProcessingState)]++
+		}
+	}
+	documentIndexModel.DocumentProcessingStateCounts = types.MapValueMust(
+		types.Int64Type,
+		func() map[string]attr.Value {
+			counts := map[string]attr.Value{}
+			for state, count := range processingStateCounts {
+				counts[state] = types.Int64Value(count)
+			}
+			return counts
+		}(),
+	)
+
+	return documentIndexModel, diags
+}
+
+func newTfDocumentIndexIndexingConfig(indexingConfig map[string]interface{}) *TfDocumentIndexIndexingConfig {
+	indexingConfigModel := &TfDocumentIndexIndexingConfig{}
+
+	if vectorizer, ok := indexingConfig["vectorizer"].(map[string]interface{}); ok {
+		config, _ := vectorizer["config"].(map[string]interface{})
+		indexingConfigModel.Vectorizer = &TfDocumentIndexVectorizer{
+			ModelName: newTfStringFromInterface(vectorizer["model_name"]),
+			Config:    newTfStringMapFromInterface(config),
+		}
+	}
+
+	if chunking, ok := indexingConfig["chunking"].(map[string]interface{}); ok {
+		chunkerConfig, _ := chunking["chunker_config"].(map[string]interface{})
+		indexingConfigModel.Chunking = &TfDocumentIndexChunking{
+			ChunkerName:   newTfStringFromInterface(chunking["chunker_name"]),
+			ChunkerConfig: newTfStringMapFromInterface(chunkerConfig),
+		}
+	}
+
+	return indexingConfigModel
+}
+
+// newTfStringFromInterface converts an arbitrary JSON value into a string,
+// JSON-encoding anything that isn't already a string.
+func newTfStringFromInterface(value interface{}) types.String {
+	switch v := value.(type) {
+	case nil:
+		return types.StringNull()
+	case string:
+		return types.StringValue(v)
+	default:
+		bytes, err := json.Marshal(v)
+		if err != nil {
+			return types.StringValue(fmt.Sprintf("%v", v))
+		}
+		return types.StringValue(string(bytes))
+	}
+}
+
+func newTfStringMapFromInterface(values map[string]interface{}) types.Map {
+	elements := map[string]attr.Value{}
+	for key, value := range values {
+		elements[key] = newTfStringFromInterface(value)
+	}
+	return types.MapValueMust(types.StringType, elements)
 }
@@ -7,15 +7,21 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 
+	"terraform-provider-vellum/internal/provider/folder"
+	"terraform-provider-vellum/internal/provider/idempotency"
+	"terraform-provider-vellum/internal/provider/timeout"
 	vellum "terraform-provider-vellum/internal/sdk"
 	vellumclient "terraform-provider-vellum/internal/sdk/client"
+	"terraform-provider-vellum/internal/sdk/core"
 )
 
 var _ resource.ResourceWithConfigure = &DocumentIndexResource{}
@@ -30,12 +36,14 @@ func Resource() resource.Resource {
 }
 
 type TfDocumentIndexResourceModel struct {
-	Created     types.String `tfsdk:"created"`
-	Environment types.String `tfsdk:"environment"`
-	Id          types.String `tfsdk:"id"`
-	Label       types.String `tfsdk:"label"`
-	Name        types.String `tfsdk:"name"`
-	Status      types.String `tfsdk:"status"`
+	Created     types.String   `tfsdk:"created"`
+	Environment types.String   `tfsdk:"environment"`
+	FolderId    types.String   `tfsdk:"folder_id"`
+	Id          types.String   `tfsdk:"id"`
+	Label       types.String   `tfsdk:"label"`
+	Name        types.String   `tfsdk:"name"`
+	Status      types.String   `tfsdk:"status"`
+	Timeouts    timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *DocumentIndexResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -63,6 +71,7 @@ func (r *DocumentIndexResource) Schema(ctx context.Context, req resource.SchemaR
 					),
 				},
 			},
+			"folder_id": folder.EntityFolderIdAttribute("Document Index"),
 			"id": schema.StringAttribute{
 				Computed:            true,
 				Description:         "The Document Index's ID",
@@ -97,6 +106,10 @@ func (r *DocumentIndexResource) Schema(ctx context.Context, req resource.SchemaR
 				},
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeout.Block(ctx),
+		},
 	}
 }
 
@@ -129,18 +142,39 @@ func (r *DocumentIndexResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
+	ctx = core.WithResource(ctx, "vellum_document_index "+documentIndexPlan.Name.ValueString())
+
+	ctx, deadline, diags := timeout.Start(ctx, documentIndexPlan.Timeouts, timeout.Create, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
 	documentIndexRequest, d := NewVellumDocumentIndexCreateRequest(ctx, documentIndexPlan)
 	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	documentIndex, err := r.client.DocumentIndexes.Create(ctx, documentIndexRequest)
+	documentIndex, err := r.client.DocumentIndexes.Create(idempotency.Context(ctx, "vellum_document_index", documentIndexRequest), documentIndexRequest)
+	if idempotency.IsConflict(err) {
+		documentIndex, err = r.adopt(ctx, documentIndexPlan, documentIndexRequest, err)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create document index, got error: %s", err))
 		return
 	}
 
+	if err := folder.MoveEntity(ctx, r.client, documentIndex.Id, documentIndexPlan.FolderId, types.StringNull(), folder.DocumentIndexRootFolderId); err != nil {
+		// The Document Index exists, so keep it in state outside of any Folder.
+		documentIndexPlan.FolderId = types.StringNull()
+		documentIndexModel, _ := NewTfDocumentIndexModel(ctx, documentIndexPlan, documentIndex)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &documentIndexModel)...)
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to move document index into folder, got error: %s", err))
+		return
+	}
+
 	documentIndexModel, diagnostic := NewTfDocumentIndexModel(ctx, documentIndexPlan, documentIndex)
 	resp.Diagnostics.Append(diagnostic...)
 	if resp.Diagnostics.HasError() {
@@ -154,6 +188,38 @@ func (r *DocumentIndexResource) Create(ctx context.Context, req resource.CreateR
 	}
 }
 
+// adopt returns the Document Index already holding the planned name, such as
+// one whose create succeeded without reaching the state, when it matches the
+// plan. Otherwise it returns why it can't be adopted, or createErr when it
+// can't be looked up.
+func (r *DocumentIndexResource) adopt(ctx context.Context, documentIndexPlan *TfDocumentIndexResourceModel, request *vellum.DocumentIndexCreateRequest, createErr error) (*vellum.DocumentIndexRead, error) {
+	documentIndex, err := r.client.DocumentIndexes.Retrieve(ctx, request.Name)
+	if err != nil {
+		return nil, createErr
+	}
+
+	var mismatches idempotency.Mismatches
+	mismatches.Compare("label", &request.Label, &documentIndex.Label)
+	// The environment and status aren't sent on create, so they're compared
+	// against the plan, when it sets them.
+	if !documentIndexPlan.Environment.IsUnknown() {
+		mismatches.Compare("environment", documentIndexPlan.Environment.ValueStringPointer(), (*string)(documentIndex.Environment))
+	}
+	if !documentIndexPlan.Status.IsUnknown() {
+		mismatches.Compare("status", documentIndexPlan.Status.ValueStringPointer(), (*string)(documentIndex.Status))
+	}
+	mismatches.CompareJSON("indexing_config", request.IndexingConfig, documentIndex.IndexingConfig)
+	if err := mismatches.Err("vellum_document_index", request.Name, documentIndex.Id); err != nil {
+		return nil, err
+	}
+
+	tflog.Info(ctx, "adopted existing document index", map[string]interface{}{
+		"id":   documentIndex.Id,
+		"name": documentIndex.Name,
+	})
+	return documentIndex, nil
+}
+
 func (r *DocumentIndexResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	var documentIndexState TfDocumentIndexResourceModel
 	var err error
@@ -162,6 +228,13 @@ func (r *DocumentIndexResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
+	ctx, deadline, diags := timeout.Start(ctx, documentIndexState.Timeouts, timeout.Read, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
 	documentIndex, err := r.client.DocumentIndexes.Retrieve(ctx, documentIndexState.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read document index, got error: %s", err))
@@ -190,6 +263,15 @@ func (r *DocumentIndexResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	ctx = core.WithResource(ctx, "vellum_document_index "+documentIndexPlan.Name.ValueString())
+
+	ctx, deadline, diags := timeout.Start(ctx, documentIndexPlan.Timeouts, timeout.Update, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
 	id := documentIndexState.Id.ValueString()
 	label := documentIndexPlan.Label.ValueString()
 
@@ -218,6 +300,11 @@ func (r *DocumentIndexResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
+	if err := folder.MoveEntity(ctx, r.client, documentIndex.Id, documentIndexPlan.FolderId, documentIndexState.FolderId, folder.DocumentIndexRootFolderId); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to move document index into folder, got error: %s", err))
+		return
+	}
+
 	documentIndexModel, diagnostic := NewTfDocumentIndexModel(ctx, documentIndexPlan, documentIndex)
 	resp.Diagnostics.Append(diagnostic...)
 	if resp.Diagnostics.HasError() {
@@ -238,6 +325,15 @@ func (r *DocumentIndexResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
+	ctx = core.WithResource(ctx, "vellum_document_index "+documentIndexState.Name.ValueString())
+
+	ctx, deadline, diags := timeout.Start(ctx, documentIndexState.Timeouts, timeout.Delete, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
 	err := r.client.DocumentIndexes.Destroy(
 		ctx,
 		documentIndexState.Id.ValueString())
@@ -0,0 +1,300 @@
+package document_index_search
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	vellumclient "terraform-provider-vellum/internal/sdk/client"
+)
+
+func DataSource() datasource.DataSource {
+	return &DocumentIndexSearchDataSource{}
+}
+
+type DocumentIndexSearchDataSource struct {
+	client *vellumclient.Client
+}
+
+var _ datasource.DataSource = &DocumentIndexSearchDataSource{}
+var _ datasource.DataSourceWithConfigure = &DocumentIndexSearchDataSource{}
+var _ datasource.DataSourceWithValidateConfig = &DocumentIndexSearchDataSource{}
+
+type TfDocumentIndexSearchDataSourceModel struct {
+	DocumentIndexId    types.String                          `tfsdk:"document_index_id"`
+	DocumentIndexName  types.String                          `tfsdk:"document_index_name"`
+	Query              types.String                          `tfsdk:"query"`
+	TopK               types.Int64                           `tfsdk:"top_k"`
+	Weights            *TfDocumentIndexSearchWeights         `tfsdk:"weights"`
+	ResultMerging      types.Bool                            `tfsdk:"result_merging"`
+	ExternalIds        types.List                            `tfsdk:"external_ids"`
+	MetadataCombinator types.String                          `tfsdk:"metadata_filter_combinator"`
+	MetadataFilters    []TfDocumentIndexSearchMetadataFilter `tfsdk:"metadata_filters"`
+	Results            []TfDocumentIndexSearchResult         `tfsdk:"results"`
+}
+
+type TfDocumentIndexSearchWeights struct {
+	SemanticSimilarity types.Float64 `tfsdk:"semantic_similarity"`
+	Keywords           types.Float64 `tfsdk:"keywords"`
+}
+
+type TfDocumentIndexSearchMetadataFilter struct {
+	Field    types.String `tfsdk:"field"`
+	Operator types.String `tfsdk:"operator"`
+	Value    types.String `tfsdk:"value"`
+}
+
+type TfDocumentIndexSearchResult struct {
+	Text               types.String  `tfsdk:"text"`
+	Score              types.Float64 `tfsdk:"score"`
+	Keywords           types.List    `tfsdk:"keywords"`
+	DocumentId         types.String  `tfsdk:"document_id"`
+	DocumentLabel      types.String  `tfsdk:"document_label"`
+	DocumentExternalId types.String  `tfsdk:"document_external_id"`
+	DocumentMetadata   types.Map     `tfsdk:"document_metadata"`
+}
+
+func (d *DocumentIndexSearchDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_document_index_search"
+}
+
+func (d *DocumentIndexSearchDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Document Index Search data source. Runs a query against a Document Index and returns the ranked chunks it matches, " +
+			"which makes it possible to assert in `check` blocks that a golden query still hits the expected Document.",
+
+		Attributes: map[string]schema.Attribute{
+			"document_index_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The ID of the Document Index to search. Exactly one of `document_index_id` or `document_index_name` must be set.",
+				MarkdownDescription: "The ID of the Document Index to search. Exactly one of `document_index_id` or `document_index_name` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("document_index_id"),
+						path.MatchRoot("document_index_name"),
+					),
+				},
+			},
+			"document_index_name": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The name of the Document Index to search. Exactly one of `document_index_id` or `document_index_name` must be set.",
+				MarkdownDescription: "The name of the Document Index to search. Exactly one of `document_index_id` or `document_index_name` must be set.",
+			},
+			"query": schema.StringAttribute{
+				Required:            true,
+				Description:         "The query to search for",
+				MarkdownDescription: "The query to search for",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"top_k": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "The maximum number of results to return",
+				MarkdownDescription: "The maximum number of results to return",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"weights": schema.SingleNestedAttribute{
+				Optional:            true,
+				Description:         "The relative weights given to semantic similarity and keyword matches. They must add up to 1.0.",
+				MarkdownDescription: "The relative weights given to semantic similarity and keyword matches. They must add up to 1.0.",
+				Attributes: map[string]schema.Attribute{
+					"semantic_similarity": schema.Float64Attribute{
+						Required:            true,
+						Description:         "The relative weight given to semantic similarity",
+						MarkdownDescription: "The relative weight given to semantic similarity",
+						Validators: []validator.Float64{
+							float64validator.Between(0, 1),
+						},
+					},
+					"keywords": schema.Float64Attribute{
+						Required:            true,
+						Description:         "The relative weight given to keyword matches",
+						MarkdownDescription: "The relative weight given to keyword matches",
+						Validators: []validator.Float64{
+							float64validator.Between(0, 1),
+						},
+					},
+				},
+			},
+			"result_merging": schema.BoolAttribute{
+				Optional:            true,
+				Description:         "Whether to merge adjacent chunks from the same Document into a single result",
+				MarkdownDescription: "Whether to merge adjacent chunks from the same Document into a single result",
+			},
+			"external_ids": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "Only search Documents with one of these external IDs",
+				MarkdownDescription: "Only search Documents with one of these external IDs",
+			},
+			"metadata_filter_combinator": schema.StringAttribute{
+				Optional:            true,
+				Description:         "How `metadata_filters` are combined. Defaults to `and`.\n\n* `and` - AND\n* `or` - OR",
+				MarkdownDescription: "How `metadata_filters` are combined. Defaults to `and`.\n\n* `and` - AND\n* `or` - OR",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"and",
+						"or",
+					),
+				},
+			},
+			"metadata_filters": schema.ListNestedAttribute{
+				Optional:            true,
+				Description:         "Rules Document metadata must satisfy for its chunks to be returned",
+				MarkdownDescription: "Rules Document metadata must satisfy for its chunks to be returned",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"field": schema.StringAttribute{
+							Required:            true,
+							Description:         "The metadata key to filter on",
+							MarkdownDescription: "The metadata key to filter on",
+						},
+						"operator": schema.StringAttribute{
+							Required:            true,
+							Description:         "The comparison operator, e.g. `=`, `!=`, `contains`, `in` or `notNull`",
+							MarkdownDescription: "The comparison operator, e.g. `=`, `!=`, `contains`, `in` or `notNull`",
+							Validators: []validator.String{
+								stringvalidator.OneOf(logicalOperators...),
+							},
+						},
+						"value": schema.StringAttribute{
+							Optional:            true,
+							Description:         "The value to compare against. Omit it for `null` and `notNull`.",
+							MarkdownDescription: "The value to compare against. Omit it for `null` and `notNull`.",
+						},
+					},
+				},
+			},
+			"results": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "The matching chunks, ranked from best to worst match",
+				MarkdownDescription: "The matching chunks, ranked from best to worst match",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"text": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The text of the chunk that matched the query",
+							MarkdownDescription: "The text of the chunk that matched the query",
+						},
+						"score": schema.Float64Attribute{
+							Computed:            true,
+							Description:         "How well the chunk matches the query",
+							MarkdownDescription: "How well the chunk matches the query",
+						},
+						"keywords": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							Description:         "The keywords of the Document that matched the query",
+							MarkdownDescription: "The keywords of the Document that matched the query",
+						},
+						"document_id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The ID of the Document the chunk belongs to",
+							MarkdownDescription: "The ID of the Document the chunk belongs to",
+						},
+						"document_label": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The label of the Document the chunk belongs to",
+							MarkdownDescription: "The label of the Document the chunk belongs to",
+						},
+						"document_external_id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The external ID of the Document the chunk belongs to",
+							MarkdownDescription: "The external ID of the Document the chunk belongs to",
+						},
+						"document_metadata": schema.MapAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							Description:         "The metadata of the Document the chunk belongs to. Non-string values are JSON-encoded.",
+							MarkdownDescription: "The metadata of the Document the chunk belongs to. Non-string values are JSON-encoded.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DocumentIndexSearchDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*vellumclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DocumentIndexSearchDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
+	var searchModel *TfDocumentIndexSearchDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &searchModel)...)
+	if resp.Diagnostics.HasError() || searchModel.Weights == nil {
+		return
+	}
+
+	weights := searchModel.Weights
+	if weights.SemanticSimilarity.IsUnknown() || weights.Keywords.IsUnknown() {
+		return
+	}
+	if sum := weights.SemanticSimilarity.ValueFloat64() + weights.Keywords.ValueFloat64(); math.Abs(sum-1) > 1e-9 {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("weights"),
+			"Invalid Search Weights",
+			fmt.Sprintf("`semantic_similarity` and `keywords` must add up to 1.0, got %g", sum),
+		)
+	}
+}
+
+func (d *DocumentIndexSearchDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var searchModel *TfDocumentIndexSearchDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &searchModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	searchRequest, diagnostic := NewVellumSearchRequest(ctx, searchModel)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	searchResponse, err := d.client.Search.Search(ctx, searchRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("error searching Document Index", err.Error())
+		return
+	}
+
+	searchModel, diagnostic = NewTfDocumentIndexSearchModel(ctx, searchModel, searchResponse)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &searchModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
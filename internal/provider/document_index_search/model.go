@@ -0,0 +1,131 @@
+package document_index_search
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-vellum/internal/provider/document"
+	vellum "terraform-provider-vellum/internal/sdk"
+)
+
+// logicalOperators lists every operator a metadata filter rule accepts.
+var logicalOperators = []string{
+	string(vellum.LogicalOperatorEquals),
+	string(vellum.LogicalOperatorDoesNotEqual),
+	string(vellum.LogicalOperatorLessThan),
+	string(vellum.LogicalOperatorGreaterThan),
+	string(vellum.LogicalOperatorLessThanOrEqualTo),
+	string(vellum.LogicalOperatorGreaterThanOrEqualTo),
+	string(vellum.LogicalOperatorContains),
+	string(vellum.LogicalOperatorBeginsWith),
+	string(vellum.LogicalOperatorEndsWith),
+	string(vellum.LogicalOperatorDoesNotContain),
+	string(vellum.LogicalOperatorDoesNotBeginWith),
+	string(vellum.LogicalOperatorDoesNotEndWith),
+	string(vellum.LogicalOperatorNull),
+	string(vellum.LogicalOperatorNotNull),
+	string(vellum.LogicalOperatorIn),
+	string(vellum.LogicalOperatorNotIn),
+	string(vellum.LogicalOperatorBetween),
+	string(vellum.LogicalOperatorNotBetween),
+}
+
+func NewVellumSearchRequest(ctx context.Context, searchModel *TfDocumentIndexSearchDataSourceModel) (*vellum.SearchRequestBodyRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	options := &vellum.SearchRequestOptionsRequest{}
+	if !searchModel.TopK.IsNull() {
+		limit := int(searchModel.TopK.ValueInt64())
+		options.Limit = &limit
+	}
+	if searchModel.Weights != nil {
+		options.Weights = &vellum.SearchWeightsRequest{
+			SemanticSimilarity: searchModel.Weights.SemanticSimilarity.ValueFloat64Pointer(),
+			Keywords:           searchModel.Weights.Keywords.ValueFloat64Pointer(),
+		}
+	}
+	if !searchModel.ResultMerging.IsNull() {
+		options.ResultMerging = &vellum.SearchResultMergingRequest{
+			Enabled: searchModel.ResultMerging.ValueBoolPointer(),
+		}
+	}
+
+	filters := &vellum.SearchFiltersRequest{}
+	if !searchModel.ExternalIds.IsNull() {
+		diags.Append(searchModel.ExternalIds.ElementsAs(ctx, &filters.ExternalIds, false)...)
+	}
+	if len(searchModel.MetadataFilters) > 0 {
+		combinator := vellum.MetadataFilterRuleCombinatorAnd
+		if !searchModel.MetadataCombinator.IsNull() {
+			c, err := vellum.NewMetadataFilterRuleCombinatorFromString(searchModel.MetadataCombinator.ValueString())
+			if err != nil {
+				diags.AddError("invalid metadata filter combinator", err.Error())
+				return nil, diags
+			}
+			combinator = c
+		}
+
+		metadata := &vellum.MetadataFilterConfigRequest{
+			Combinator: &combinator,
+		}
+		for _, filter := range searchModel.MetadataFilters {
+			operator, err := vellum.NewLogicalOperatorFromString(filter.Operator.ValueString())
+			if err != nil {
+				diags.AddError("invalid metadata filter operator", err.Error())
+				return nil, diags
+			}
+			metadata.Rules = append(metadata.Rules, &vellum.MetadataFilterRuleRequest{
+				Field:    filter.Field.ValueStringPointer(),
+				Operator: &operator,
+				Value:    filter.Value.ValueStringPointer(),
+			})
+		}
+		filters.Metadata = metadata
+	}
+	if filters.ExternalIds != nil || filters.Metadata != nil {
+		options.Filters = filters
+	}
+
+	request := vellum.SearchRequestBodyRequest{
+		IndexId:   searchModel.DocumentIndexId.ValueStringPointer(),
+		IndexName: searchModel.DocumentIndexName.ValueStringPointer(),
+		Query:     searchModel.Query.ValueString(),
+		Options:   options,
+	}
+
+	return &request, diags
+}
+
+func NewTfDocumentIndexSearchModel(ctx context.Context, model *TfDocumentIndexSearchDataSourceModel, searchResponse *vellum.SearchResponse) (*TfDocumentIndexSearchDataSourceModel, diag.Diagnostics) {
+	searchModel := *model
+	searchModel.Results = []TfDocumentIndexSearchResult{}
+
+	for _, result := range searchResponse.Results {
+		keywords := []attr.Value{}
+		for _, keyword := range result.Keywords {
+			keywords = append(keywords, types.StringValue(keyword))
+		}
+
+		searchResult := TfDocumentIndexSearchResult{
+			Text:               types.StringValue(result.Text),
+			Score:              types.Float64Value(result.Score),
+			Keywords:           types.ListValueMust(types.StringType, keywords),
+			DocumentId:         types.StringNull(),
+			DocumentLabel:      types.StringNull(),
+			DocumentExternalId: types.StringNull(),
+			DocumentMetadata:   types.MapNull(types.StringType),
+		}
+		if result.Document != nil {
+			searchResult.DocumentId = types.StringPointerValue(result.Document.Id)
+			searchResult.DocumentLabel = types.StringValue(result.Document.Label)
+			searchResult.DocumentExternalId = types.StringPointerValue(result.Document.ExternalId)
+			searchResult.DocumentMetadata = document.NewTfDocumentMetadata(result.Document.Metadata, types.MapNull(types.StringType))
+		}
+		searchModel.Results = append(searchModel.Results, searchResult)
+	}
+
+	return &searchModel, nil
+}
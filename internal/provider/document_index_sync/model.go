@@ -0,0 +1,72 @@
+package document_index_sync
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func NewTfDocumentIndexSyncModel(ctx context.Context, model *TfDocumentIndexSyncResourceModel, files manifest) (*TfDocumentIndexSyncResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	fileHashes, d := types.MapValueFrom(ctx, types.StringType, files)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	syncModel := &TfDocumentIndexSyncResourceModel{
+		Id:               model.DocumentIndexId,
+		DocumentIndexId:  model.DocumentIndexId,
+		Directory:        model.Directory,
+		Include:          model.Include,
+		Exclude:          model.Exclude,
+		ExternalIdPrefix: model.ExternalIdPrefix,
+		Concurrency:      model.Concurrency,
+		// Imported syncs have no wait_for_processing yet.
+		WaitForProcessing: types.BoolValue(model.WaitForProcessing.IsNull() || model.WaitForProcessing.ValueBool()),
+		FileHashes:        fileHashes,
+		FileCount:         types.Int64Value(int64(len(files))),
+		Timeouts:          model.Timeouts,
+	}
+
+	return syncModel, diags
+}
+
+// scanTfDocumentIndexSync validates the include and exclude globs and
+// hashes every file they select.
+func scanTfDocumentIndexSync(model *TfDocumentIndexSyncResourceModel) (manifest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	globs := map[string][]string{
+		"include": tfStringList(model.Include),
+		"exclude": tfStringList(model.Exclude),
+	}
+	for attribute, patterns := range globs {
+		for _, pattern := range patterns {
+			if err := validateGlob(pattern); err != nil {
+				diags.AddAttributeError(path.Root(attribute), "invalid glob", err.Error())
+			}
+		}
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	files, err := scanDirectory(model.Directory.ValueString(), globs["include"], globs["exclude"])
+	if err != nil {
+		diags.AddAttributeError(path.Root("directory"), "failed to scan directory", err.Error())
+		return nil, diags
+	}
+	return files, diags
+}
+
+func tfStringList(list types.List) []string {
+	var values []string
+	for _, value := range list.Elements() {
+		values = append(values, value.(types.String).ValueString())
+	}
+	return values
+}
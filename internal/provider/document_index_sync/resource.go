@@ -0,0 +1,357 @@
+package document_index_sync
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-vellum/internal/provider/document"
+	"terraform-provider-vellum/internal/provider/timeout"
+	vellumclient "terraform-provider-vellum/internal/sdk/client"
+	"terraform-provider-vellum/internal/sdk/core"
+)
+
+var _ resource.ResourceWithConfigure = &DocumentIndexSyncResource{}
+var _ resource.ResourceWithImportState = &DocumentIndexSyncResource{}
+var _ resource.ResourceWithModifyPlan = &DocumentIndexSyncResource{}
+
+type DocumentIndexSyncResource struct {
+	client *vellumclient.Client
+}
+
+func Resource() resource.Resource {
+	return &DocumentIndexSyncResource{}
+}
+
+type TfDocumentIndexSyncResourceModel struct {
+	Id                types.String   `tfsdk:"id"`
+	DocumentIndexId   types.String   `tfsdk:"document_index_id"`
+	Directory         types.String   `tfsdk:"directory"`
+	Include           types.List     `tfsdk:"include"`
+	Exclude           types.List     `tfsdk:"exclude"`
+	ExternalIdPrefix  types.String   `tfsdk:"external_id_prefix"`
+	Concurrency       types.Int64    `tfsdk:"concurrency"`
+	WaitForProcessing types.Bool     `tfsdk:"wait_for_processing"`
+	FileHashes        types.Map      `tfsdk:"file_hashes"`
+	FileCount         types.Int64    `tfsdk:"file_count"`
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *DocumentIndexSyncResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_document_index_sync"
+}
+
+func (r *DocumentIndexSyncResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Document Index Sync resource. Mirrors a local directory into a Document Index, " +
+			"uploading new and changed files and deleting Documents whose files were removed. " +
+			"Files are matched to Documents by external ID, and only Documents uploaded by a sync are ever deleted.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The ID of the synced Document Index",
+				MarkdownDescription: "The ID of the synced Document Index",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"document_index_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Document Index to sync the directory into",
+				MarkdownDescription: "The ID of the Document Index to sync the directory into",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"directory": schema.StringAttribute{
+				Required:            true,
+				Description:         "The local directory to sync",
+				MarkdownDescription: "The local directory to sync",
+			},
+			"include": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "Globs of the files to sync, relative to `directory`. `**` matches any number of directories. Defaults to every file.",
+				MarkdownDescription: "Globs of the files to sync, relative to `directory`. `**` matches any number of directories. Defaults to every file.",
+			},
+			"exclude": schema.ListAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "Globs of the files to leave out, relative to `directory`. Takes precedence over `include`.",
+				MarkdownDescription: "Globs of the files to leave out, relative to `directory`. Takes precedence over `include`.",
+			},
+			"external_id_prefix": schema.StringAttribute{
+				Optional:            true,
+				Description:         "A prefix prepended to every file's relative path to form its Document's external ID. Use distinct prefixes when several syncs share an index.",
+				MarkdownDescription: "A prefix prepended to every file's relative path to form its Document's external ID. Use distinct prefixes when several syncs share an index.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"concurrency": schema.Int64Attribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             int64default.StaticInt64(4),
+				Description:         "The maximum number of uploads and deletes in flight at once",
+				MarkdownDescription: "The maximum number of uploads and deletes in flight at once",
+				Validators: []validator.Int64{
+					int64validator.Between(1, 32),
+				},
+			},
+			"wait_for_processing": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+				Description:         "Whether to wait, up to the `create` or `update` timeout, for every uploaded Document to be indexed. Defaults to true; disable it for very large corpora.",
+				MarkdownDescription: "Whether to wait, up to the `create` or `update` timeout, for every uploaded Document to be indexed. Defaults to `true`; disable it for very large corpora.",
+			},
+			"file_hashes": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				Description:         "The SHA-256 hash of every synced file's contents, keyed by its path relative to directory",
+				MarkdownDescription: "The SHA-256 hash of every synced file's contents, keyed by its path relative to `directory`",
+			},
+			"file_count": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "The number of synced files",
+				MarkdownDescription: "The number of synced files",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeout.Block(ctx),
+		},
+	}
+}
+
+func (r *DocumentIndexSyncResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*vellumclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *DocumentIndexSyncResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var syncPlan *TfDocumentIndexSyncResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &syncPlan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if syncPlan.Directory.IsUnknown() || syncPlan.Include.IsUnknown() || syncPlan.Exclude.IsUnknown() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("file_hashes"), types.MapUnknown(types.StringType))...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("file_count"), types.Int64Unknown())...)
+		return
+	}
+
+	local, diags := scanTfDocumentIndexSync(syncPlan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	fileHashes, diags := types.MapValueFrom(ctx, types.StringType, local)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("file_hashes"), fileHashes)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("file_count"), types.Int64Value(int64(len(local))))...)
+}
+
+func (r *DocumentIndexSyncResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var syncPlan *TfDocumentIndexSyncResourceModel
+
+	diags := req.Plan.Get(ctx, &syncPlan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.WithResource(ctx, "vellum_document_index_sync "+syncPlan.DocumentIndexId.ValueString())
+
+	ctx, deadline, diags := timeout.Start(ctx, syncPlan.Timeouts, timeout.Create, document.DefaultProcessingTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	syncModel, diagnostic := r.sync(ctx, syncPlan)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &syncModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DocumentIndexSyncResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var syncState TfDocumentIndexSyncResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &syncState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, deadline, diags := timeout.Start(ctx, syncState.Timeouts, timeout.Read, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	synced, err := listSyncedDocuments(ctx, r.client, syncState.DocumentIndexId.ValueString(), syncState.ExternalIdPrefix.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list synced documents, got error: %s", err))
+		return
+	}
+
+	syncModel, diagnostic := NewTfDocumentIndexSyncModel(ctx, &syncState, remoteManifest(synced))
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &syncModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DocumentIndexSyncResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var syncPlan *TfDocumentIndexSyncResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &syncPlan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.WithResource(ctx, "vellum_document_index_sync "+syncPlan.DocumentIndexId.ValueString())
+
+	ctx, deadline, diags := timeout.Start(ctx, syncPlan.Timeouts, timeout.Update, document.DefaultProcessingTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	syncModel, diagnostic := r.sync(ctx, syncPlan)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &syncModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *DocumentIndexSyncResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var syncState *TfDocumentIndexSyncResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &syncState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.WithResource(ctx, "vellum_document_index_sync "+syncState.DocumentIndexId.ValueString())
+
+	ctx, deadline, diags := timeout.Start(ctx, syncState.Timeouts, timeout.Delete, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	synced, err := listSyncedDocuments(ctx, r.client, syncState.DocumentIndexId.ValueString(), syncState.ExternalIdPrefix.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("error when destroying the document index sync resource", err.Error())
+		return
+	}
+
+	s := &syncer{
+		client:           r.client,
+		externalIdPrefix: syncState.ExternalIdPrefix.ValueString(),
+		concurrency:      int(syncState.Concurrency.ValueInt64()),
+	}
+	for _, err := range s.apply(ctx, manifest{}, newSyncPlan(manifest{}, synced)) {
+		resp.Diagnostics.AddError("error when destroying the document index sync resource", err.Error())
+	}
+}
+
+func (r *DocumentIndexSyncResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("document_index_id"), req.ID)...)
+}
+
+// sync uploads every new and changed file and deletes the Documents of
+// removed files, returning the resulting state.
+func (r *DocumentIndexSyncResource) sync(ctx context.Context, syncPlan *TfDocumentIndexSyncResourceModel) (*TfDocumentIndexSyncResourceModel, diag.Diagnostics) {
+	local, diags := scanTfDocumentIndexSync(syncPlan)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	documentIndexId := syncPlan.DocumentIndexId.ValueString()
+	documentIndex, err := r.client.DocumentIndexes.Retrieve(ctx, documentIndexId)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read document index, got error: %s", err))
+		return nil, diags
+	}
+
+	synced, err := listSyncedDocuments(ctx, r.client, documentIndexId, syncPlan.ExternalIdPrefix.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list synced documents, got error: %s", err))
+		return nil, diags
+	}
+
+	s := &syncer{
+		client:            r.client,
+		directory:         syncPlan.Directory.ValueString(),
+		documentIndexName: documentIndex.Name,
+		externalIdPrefix:  syncPlan.ExternalIdPrefix.ValueString(),
+		concurrency:       int(syncPlan.Concurrency.ValueInt64()),
+		waitForProcessing: syncPlan.WaitForProcessing.ValueBool(),
+	}
+	for _, err := range s.apply(ctx, local, newSyncPlan(local, synced)) {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to sync document index, got error: %s", err))
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return NewTfDocumentIndexSyncModel(ctx, syncPlan, local)
+}
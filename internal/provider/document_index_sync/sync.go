@@ -0,0 +1,183 @@
+package document_index_sync
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-vellum/internal/provider/document"
+	vellum "terraform-provider-vellum/internal/sdk"
+	vellumclient "terraform-provider-vellum/internal/sdk/client"
+)
+
+// contentHashMetadataKey is the Document metadata key synced Documents carry
+// their content hash under. It also marks a Document as owned by a sync, so
+// Documents uploaded by other means are never deleted.
+const contentHashMetadataKey = "terraform_sync_content_hash"
+
+// syncPlan lists the files to upload and the synced Documents to delete.
+type syncPlan struct {
+	uploads []string
+	deletes []*vellum.SlimDocument
+}
+
+// listSyncedDocuments returns every Document in the index that was uploaded
+// by a sync with the given external ID prefix, keyed by its relative path.
+func listSyncedDocuments(ctx context.Context, client *vellumclient.Client, documentIndexId string, externalIdPrefix string) (map[string]*vellum.SlimDocument, error) {
+	documents, err := client.Documents.ListAll(ctx, &vellum.DocumentsListRequest{
+		DocumentIndexId: &documentIndexId,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	synced := map[string]*vellum.SlimDocument{}
+	for _, document := range documents {
+		if document.ExternalId == nil || !strings.HasPrefix(*document.ExternalId, externalIdPrefix) {
+			continue
+		}
+		if _, ok := document.Metadata[contentHashMetadataKey].(string); !ok {
+			continue
+		}
+		synced[strings.TrimPrefix(*document.ExternalId, externalIdPrefix)] = document
+	}
+	return synced, nil
+}
+
+// remoteManifest rebuilds a manifest from the content hashes synced
+// Documents carry in their metadata.
+func remoteManifest(synced map[string]*vellum.SlimDocument) manifest {
+	files := manifest{}
+	for relativePath, document := range synced {
+		files[relativePath] = document.Metadata[contentHashMetadataKey].(string)
+	}
+	return files
+}
+
+// newSyncPlan diffs the local files against the synced Documents.
+func newSyncPlan(local manifest, synced map[string]*vellum.SlimDocument) *syncPlan {
+	plan := &syncPlan{}
+	for relativePath, hash := range local {
+		document, ok := synced[relativePath]
+		if !ok || document.Metadata[contentHashMetadataKey] != hash {
+			plan.uploads = append(plan.uploads, relativePath)
+		}
+	}
+	for relativePath, document := range synced {
+		if _, ok := local[relativePath]; !ok {
+			plan.deletes = append(plan.deletes, document)
+		}
+	}
+	sort.Strings(plan.uploads)
+	return plan
+}
+
+// syncer applies a syncPlan against a single Document Index.
+type syncer struct {
+	client            *vellumclient.Client
+	directory         string
+	documentIndexName string
+	externalIdPrefix  string
+	concurrency       int
+	waitForProcessing bool
+}
+
+// apply uploads and deletes Documents with at most s.concurrency requests
+// in flight, returning every error encountered.
+func (s *syncer) apply(ctx context.Context, local manifest, plan *syncPlan) []error {
+	tflog.Info(ctx, "syncing document index", map[string]interface{}{
+		"document_index": s.documentIndexName,
+		"uploads":        len(plan.uploads),
+		"deletes":        len(plan.deletes),
+	})
+
+	var tasks []func(context.Context) error
+	for _, relativePath := range plan.uploads {
+		relativePath := relativePath
+		tasks = append(tasks, func(ctx context.Context) error {
+			return s.upload(ctx, relativePath, local[relativePath])
+		})
+	}
+	for _, document := range plan.deletes {
+		document := document
+		tasks = append(tasks, func(ctx context.Context) error {
+			if err := s.client.Documents.Destroy(ctx, document.Id); err != nil {
+				return fmt.Errorf("unable to delete %s: %w", *document.ExternalId, err)
+			}
+			return nil
+		})
+	}
+
+	return runConcurrently(ctx, s.concurrency, tasks)
+}
+
+func (s *syncer) upload(ctx context.Context, relativePath string, hash string) error {
+	file, err := os.Open(filepath.Join(s.directory, filepath.FromSlash(relativePath)))
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	externalId := s.externalIdPrefix + relativePath
+	uploadResponse, err := s.client.Documents.Upload(ctx, file, path.Base(relativePath), &vellum.UploadDocumentBodyRequest{
+		AddToIndexNames: []string{s.documentIndexName},
+		ExternalId:      &externalId,
+		Label:           relativePath,
+		Metadata: map[string]interface{}{
+			contentHashMetadataKey: hash,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("unable to upload %s: %w", relativePath, err)
+	}
+
+	if s.waitForProcessing {
+		if _, err := document.WaitForProcessing(ctx, s.client, uploadResponse.DocumentId); err != nil {
+			return fmt.Errorf("unable to process %s: %w", relativePath, err)
+		}
+	}
+	return nil
+}
+
+// runConcurrently runs every task with at most concurrency tasks in flight.
+// Tasks that haven't started are skipped once the context is cancelled.
+func runConcurrently(ctx context.Context, concurrency int, tasks []func(context.Context) error) []error {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	var (
+		wg     sync.WaitGroup
+		mu     sync.Mutex
+		errs   []error
+		limits = make(chan struct{}, concurrency)
+	)
+	for _, task := range tasks {
+		if err := ctx.Err(); err != nil {
+			mu.Lock()
+			errs = append(errs, err)
+			mu.Unlock()
+			break
+		}
+		limits <- struct{}{}
+		wg.Add(1)
+		go func(task func(context.Context) error) {
+			defer wg.Done()
+			defer func() { <-limits }()
+			if err := task(ctx); err != nil {
+				mu.Lock()
+				errs = append(errs, err)
+				mu.Unlock()
+			}
+		}(task)
+	}
+	wg.Wait()
+	return errs
+}
@@ -0,0 +1,207 @@
+package documents
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	vellum "terraform-provider-vellum/internal/sdk"
+	vellumclient "terraform-provider-vellum/internal/sdk/client"
+)
+
+func DataSource() datasource.DataSource {
+	return &DocumentsDataSource{}
+}
+
+type DocumentsDataSource struct {
+	client *vellumclient.Client
+}
+
+var _ datasource.DataSource = &DocumentsDataSource{}
+var _ datasource.DataSourceWithConfigure = &DocumentsDataSource{}
+
+type TfDocumentsDataSourceModel struct {
+	DocumentIndexId  types.String         `tfsdk:"document_index_id"`
+	ProcessingStates types.Set            `tfsdk:"processing_states"`
+	Status           types.String         `tfsdk:"status"`
+	Documents        []TfDocumentsElement `tfsdk:"documents"`
+}
+
+type TfDocumentsElement struct {
+	Id                      types.String `tfsdk:"id"`
+	Label                   types.String `tfsdk:"label"`
+	ExternalId              types.String `tfsdk:"external_id"`
+	Metadata                types.Map    `tfsdk:"metadata"`
+	ProcessingState         types.String `tfsdk:"processing_state"`
+	ProcessingFailureReason types.String `tfsdk:"processing_failure_reason"`
+	Status                  types.String `tfsdk:"status"`
+	LastUploadedAt          types.String `tfsdk:"last_uploaded_at"`
+	DocumentIndexIds        types.List   `tfsdk:"document_index_ids"`
+}
+
+func (d *DocumentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_documents"
+}
+
+func (d *DocumentsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Documents data source. Lists Documents, optionally only those in a given Document Index, processing state or status.",
+
+		Attributes: map[string]schema.Attribute{
+			"document_index_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only list Documents included in this Document Index. Either its ID or its name may be given.",
+				MarkdownDescription: "Only list Documents included in this Document Index. Either its ID or its name may be given.",
+			},
+			"processing_states": schema.SetAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "Only list Documents in one of these processing states\n\n* `QUEUED` - Queued\n* `PROCESSING` - Processing\n* `PROCESSED` - Processed\n* `FAILED` - Failed",
+				MarkdownDescription: "Only list Documents in one of these processing states\n\n* `QUEUED` - Queued\n* `PROCESSING` - Processing\n* `PROCESSED` - Processed\n* `FAILED` - Failed",
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.OneOf(
+							"QUEUED",
+							"PROCESSING",
+							"PROCESSED",
+							"FAILED",
+						),
+					),
+				},
+			},
+			"status": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Only list Documents with this status\n\n* `ACTIVE` - Active",
+				MarkdownDescription: "Only list Documents with this status\n\n* `ACTIVE` - Active",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						"ACTIVE",
+					),
+				},
+			},
+			"documents": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "The matching Documents",
+				MarkdownDescription: "The matching Documents",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The Document's ID",
+							MarkdownDescription: "The Document's ID",
+						},
+						"label": schema.StringAttribute{
+							Computed:            true,
+							Description:         "A human-readable label for the Document",
+							MarkdownDescription: "A human-readable label for the Document",
+						},
+						"external_id": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The unique ID of this Document as it exists in your own system",
+							MarkdownDescription: "The unique ID of this Document as it exists in your own system",
+						},
+						"metadata": schema.MapAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							Description:         "The Document's metadata. Non-string values are JSON-encoded.",
+							MarkdownDescription: "The Document's metadata. Non-string values are JSON-encoded.",
+						},
+						"processing_state": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Where the Document is along its processing lifecycle\n\n* `QUEUED` - Queued\n* `PROCESSING` - Processing\n* `PROCESSED` - Processed\n* `FAILED` - Failed",
+							MarkdownDescription: "Where the Document is along its processing lifecycle\n\n* `QUEUED` - Queued\n* `PROCESSING` - Processing\n* `PROCESSED` - Processed\n* `FAILED` - Failed",
+						},
+						"processing_failure_reason": schema.StringAttribute{
+							Computed:            true,
+							Description:         "Why the Document could not be processed. Null unless `processing_state` is `FAILED`.\n\n* `EXCEEDED_CHARACTER_LIMIT` - Exceeded Character Limit\n* `INVALID_FILE` - Invalid File",
+							MarkdownDescription: "Why the Document could not be processed. Null unless `processing_state` is `FAILED`.\n\n* `EXCEEDED_CHARACTER_LIMIT` - Exceeded Character Limit\n* `INVALID_FILE` - Invalid File",
+						},
+						"status": schema.StringAttribute{
+							Computed:            true,
+							Description:         "The Document's current status\n\n* `ACTIVE` - Active",
+							MarkdownDescription: "The Document's current status\n\n* `ACTIVE` - Active",
+						},
+						"last_uploaded_at": schema.StringAttribute{
+							Computed:            true,
+							Description:         "When the Document was most recently uploaded",
+							MarkdownDescription: "When the Document was most recently uploaded",
+						},
+						"document_index_ids": schema.ListAttribute{
+							Computed:            true,
+							ElementType:         types.StringType,
+							Description:         "The IDs of the Document Indexes this Document is included in",
+							MarkdownDescription: "The IDs of the Document Indexes this Document is included in",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *DocumentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*vellumclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *DocumentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var documentsModel *TfDocumentsDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &documentsModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	filter, diagnostic := newDocumentsFilter(ctx, documentsModel)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	documents, err := d.client.Documents.ListAll(ctx, &vellum.DocumentsListRequest{
+		DocumentIndexId: documentsModel.DocumentIndexId.ValueStringPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("error listing Documents", err.Error())
+		return
+	}
+
+	var matching []*vellum.SlimDocument
+	for _, document := range documents {
+		if filter.matches(document) {
+			matching = append(matching, document)
+		}
+	}
+
+	documentsModel, diagnostic = NewTfDocumentsDataSourceModel(ctx, documentsModel, matching)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &documentsModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
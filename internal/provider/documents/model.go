@@ -0,0 +1,91 @@
+package documents
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	tfdocument "terraform-provider-vellum/internal/provider/document"
+	vellum "terraform-provider-vellum/internal/sdk"
+)
+
+// documentsFilter holds the filters the list endpoint doesn't support, which
+// are applied to every listed Document instead.
+type documentsFilter struct {
+	processingStates map[string]bool
+	status           string
+}
+
+func newDocumentsFilter(ctx context.Context, model *TfDocumentsDataSourceModel) (*documentsFilter, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	filter := &documentsFilter{
+		status: model.Status.ValueString(),
+	}
+	if !model.ProcessingStates.IsNull() {
+		var processingStates []string
+		diags.Append(model.ProcessingStates.ElementsAs(ctx, &processingStates, false)...)
+		filter.processingStates = map[string]bool{}
+		for _, processingState := range processingStates {
+			filter.processingStates[processingState] = true
+		}
+	}
+	return filter, diags
+}
+
+func (f *documentsFilter) matches(document *vellum.SlimDocument) bool {
+	if f.processingStates != nil {
+		if document.ProcessingState == nil || !f.processingStates[string(*document.ProcessingState)] {
+			return false
+		}
+	}
+	if f.status != "" {
+		if document.Status == nil || string(*document.Status) != f.status {
+			return false
+		}
+	}
+	return true
+}
+
+func NewTfDocumentsDataSourceModel(ctx context.Context, model *TfDocumentsDataSourceModel, documents []*vellum.SlimDocument) (*TfDocumentsDataSourceModel, diag.Diagnostics) {
+	documentsModel := &TfDocumentsDataSourceModel{
+		DocumentIndexId:  model.DocumentIndexId,
+		ProcessingStates: model.ProcessingStates,
+		Status:           model.Status,
+		Documents:        []TfDocumentsElement{},
+	}
+
+	for _, document := range documents {
+		element := TfDocumentsElement{
+			Id:                      types.StringValue(document.Id),
+			Label:                   types.StringValue(document.Label),
+			ExternalId:              types.StringPointerValue(document.ExternalId),
+			Metadata:                tfdocument.NewTfDocumentMetadata(document.Metadata, types.MapNull(types.StringType)),
+			ProcessingState:         types.StringNull(),
+			ProcessingFailureReason: types.StringNull(),
+			Status:                  types.StringNull(),
+			LastUploadedAt:          types.StringValue(document.LastUploadedAt.String()),
+		}
+		if document.ProcessingState != nil {
+			element.ProcessingState = types.StringValue(string(*document.ProcessingState))
+		}
+		if document.ProcessingFailureReason != nil {
+			element.ProcessingFailureReason = types.StringValue(string(*document.ProcessingFailureReason))
+		}
+		if document.Status != nil {
+			element.Status = types.StringValue(string(*document.Status))
+		}
+
+		documentIndexIds := []attr.Value{}
+		for _, documentToDocumentIndex := range document.DocumentToDocumentIndexes {
+			documentIndexIds = append(documentIndexIds, types.StringValue(documentToDocumentIndex.DocumentIndexId))
+		}
+		element.DocumentIndexIds = types.ListValueMust(types.StringType, documentIndexIds)
+
+		documentsModel.Documents = append(documentsModel.Documents, element)
+	}
+
+	return documentsModel, nil
+}
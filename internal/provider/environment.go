@@ -0,0 +1,64 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	vellum "terraform-provider-vellum/internal/sdk"
+	"terraform-provider-vellum/internal/sdk/core"
+)
+
+// environments are the Vellum environments the `environment` attribute can
+// name.
+var environments = map[string]*core.Environment{
+	"production": vellum.Environments.Production,
+}
+
+// newEnvironment returns the Vellum environment the provider calls, taken
+// from, in order of precedence:
+//
+//  1. the base_url or environment attribute, which conflict,
+//  2. the VELLUM_BASE_URL environment variable,
+//  3. production,
+//
+// with the path_prefix attribute appended to it.
+func newEnvironment(data *VellumProviderModel) (*core.Environment, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	environment := vellum.Environments.Production
+	switch {
+	case data.BaseUrl.ValueString() != "":
+		parsed, err := core.NewEnvironment(data.BaseUrl.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("base_url"), "Invalid Base URL", err.Error())
+			return nil, diags
+		}
+		environment = parsed
+	case data.Environment.ValueString() != "":
+		// The attribute's validator only allows known environments.
+		environment = environments[data.Environment.ValueString()]
+	case os.Getenv("VELLUM_BASE_URL") != "":
+		parsed, err := core.NewEnvironment(os.Getenv("VELLUM_BASE_URL"))
+		if err != nil {
+			diags.AddError(
+				"Invalid VELLUM_BASE_URL Environment Variable",
+				fmt.Sprintf("Expected VELLUM_BASE_URL to be a base URL such as https://api.vellum.ai, got error: %s", err),
+			)
+			return nil, diags
+		}
+		environment = parsed
+	}
+
+	environment, err := environment.WithPathPrefix(data.PathPrefix.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("path_prefix"), "Invalid Path Prefix", err.Error())
+		return nil, diags
+	}
+	return environment, diags
+}
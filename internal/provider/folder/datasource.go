@@ -0,0 +1,154 @@
+package folder
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	vellum "terraform-provider-vellum/internal/sdk"
+	vellumclient "terraform-provider-vellum/internal/sdk/client"
+)
+
+func DataSource() datasource.DataSource {
+	return &FolderDataSource{}
+}
+
+type FolderDataSource struct {
+	client *vellumclient.Client
+}
+
+var _ datasource.DataSource = &FolderDataSource{}
+var _ datasource.DataSourceWithConfigure = &FolderDataSource{}
+
+type TfFolderDataSourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	Path           types.String `tfsdk:"path"`
+	Name           types.String `tfsdk:"name"`
+	ParentFolderId types.String `tfsdk:"parent_folder_id"`
+	Created        types.String `tfsdk:"created"`
+}
+
+func (d *FolderDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_folder"
+}
+
+func (d *FolderDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Folder data source. Looks a Folder up by ID, or by a path of Folder names such as `rag/prod/support`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The Folder's ID. Exactly one of `id` or `path` must be set.",
+				MarkdownDescription: "The Folder's ID. Exactly one of `id` or `path` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("id"),
+						path.MatchRoot("path"),
+					),
+				},
+			},
+			"path": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The slash-separated names of the Folder and the Folders it's nested in, starting from the top level",
+				MarkdownDescription: "The slash-separated names of the Folder and the Folders it's nested in, starting from the top level, such as `rag/prod/support`",
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The Folder's name",
+				MarkdownDescription: "The Folder's name",
+			},
+			"parent_folder_id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The ID of the Folder this Folder is nested in, if any",
+				MarkdownDescription: "The ID of the Folder this Folder is nested in, if any",
+			},
+			"created": schema.StringAttribute{
+				Computed:            true,
+				Description:         "When the Folder was created",
+				MarkdownDescription: "When the Folder was created",
+			},
+		},
+	}
+}
+
+func (d *FolderDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*vellumclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *FolderDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TfFolderDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folders, err := d.client.Folders.ListAll(ctx, &vellum.FoldersListRequest{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list folders, got error: %s", err))
+		return
+	}
+	tree := newFolderTree(folders)
+
+	var folder *vellum.FolderRead
+	if !data.Path.IsNull() {
+		folder, err = tree.resolve(data.Path.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("path"), "Folder Not Found", err.Error())
+			return
+		}
+	} else {
+		var ok bool
+		if folder, ok = tree.folders[data.Id.ValueString()]; !ok {
+			resp.Diagnostics.AddAttributeError(path.Root("id"), "Folder Not Found", fmt.Sprintf("No folder found with ID %q", data.Id.ValueString()))
+			return
+		}
+	}
+
+	// A configured path is kept as written, so a trailing slash doesn't
+	// produce an inconsistent result.
+	folderPath := data.Path.ValueString()
+	if data.Path.IsNull() {
+		folderPath, err = tree.path(folder.Id)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to resolve folder path, got error: %s", err))
+			return
+		}
+	}
+
+	folderModel, diagnostic := NewTfFolderDataSourceModel(ctx, folder, folderPath)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &folderModel)...)
+}
@@ -0,0 +1,50 @@
+package folder
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	vellum "terraform-provider-vellum/internal/sdk"
+	vellumclient "terraform-provider-vellum/internal/sdk/client"
+)
+
+// The root folders entities live in when they aren't filed into a Folder.
+const (
+	DeploymentRootFolderId    = "DEPLOYMENT"
+	DocumentIndexRootFolderId = "DOCUMENT_INDEX"
+	MLModelRootFolderId       = "ML_MODEL"
+	TestSuiteRootFolderId     = "TEST_SUITE"
+)
+
+// EntityFolderIdAttribute returns the folder_id attribute of a resource whose
+// entity can be filed into a Folder. Vellum doesn't report which Folder an
+// entity is in, so the attribute is never refreshed from Vellum.
+func EntityFolderIdAttribute(entityName string) schema.StringAttribute {
+	description := fmt.Sprintf("The ID of the Folder to file the %[1]s into. Changing it moves the %[1]s in place, "+
+		"and unsetting it moves the %[1]s back to the top level.", entityName)
+	return schema.StringAttribute{
+		Optional:            true,
+		Description:         description,
+		MarkdownDescription: description,
+	}
+}
+
+// MoveEntity files the entity into the planned Folder, or back into its root
+// folder when there's none. Nothing is sent when the planned Folder matches
+// the prior one, so pass a null prior Folder for newly created entities.
+func MoveEntity(ctx context.Context, client *vellumclient.Client, entityId string, planned types.String, prior types.String, rootFolderId string) error {
+	if planned.Equal(prior) {
+		return nil
+	}
+
+	folderId := rootFolderId
+	if !planned.IsNull() {
+		folderId = planned.ValueString()
+	}
+	return client.FolderEntities.AddEntityToFolder(ctx, folderId, &vellum.AddEntityToFolderRequest{
+		EntityId: entityId,
+	})
+}
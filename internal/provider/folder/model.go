@@ -0,0 +1,130 @@
+package folder
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	vellum "terraform-provider-vellum/internal/sdk"
+)
+
+func NewVellumFolderCreateRequest(ctx context.Context, folderModel *TfFolderResourceModel) (*vellum.FolderCreateRequest, diag.Diagnostics) {
+	request := vellum.FolderCreateRequest{
+		Name:           folderModel.Name.ValueString(),
+		ParentFolderId: folderModel.ParentFolderId.ValueStringPointer(),
+	}
+
+	return &request, nil
+}
+
+func NewVellumFolderUpdateRequest(ctx context.Context, folderModel *TfFolderResourceModel) (*vellum.PatchedFolderUpdateRequest, diag.Diagnostics) {
+	request := vellum.PatchedFolderUpdateRequest{
+		Name:           folderModel.Name.ValueStringPointer(),
+		ParentFolderId: folderModel.ParentFolderId.ValueStringPointer(),
+	}
+
+	return &request, nil
+}
+
+func NewTfFolderModel(ctx context.Context, model *TfFolderResourceModel, folder *vellum.FolderRead) (*TfFolderResourceModel, diag.Diagnostics) {
+	folderModel := &TfFolderResourceModel{
+		Id:             types.StringValue(folder.Id),
+		Name:           types.StringValue(folder.Name),
+		ParentFolderId: types.StringPointerValue(folder.ParentFolderId),
+		Created:        types.StringValue(folder.Created.String()),
+		Timeouts:       model.Timeouts,
+	}
+
+	return folderModel, nil
+}
+
+func NewTfFolderDataSourceModel(ctx context.Context, folder *vellum.FolderRead, folderPath string) (*TfFolderDataSourceModel, diag.Diagnostics) {
+	folderModel := &TfFolderDataSourceModel{
+		Id:             types.StringValue(folder.Id),
+		Path:           types.StringValue(folderPath),
+		Name:           types.StringValue(folder.Name),
+		ParentFolderId: types.StringPointerValue(folder.ParentFolderId),
+		Created:        types.StringValue(folder.Created.String()),
+	}
+
+	return folderModel, nil
+}
+
+// folderTree indexes every Folder of a workspace, so paths can be resolved
+// without a request per path segment.
+type folderTree struct {
+	folders  map[string]*vellum.FolderRead
+	children map[string][]*vellum.FolderRead
+}
+
+func newFolderTree(folders []*vellum.FolderRead) *folderTree {
+	tree := &folderTree{
+		folders:  map[string]*vellum.FolderRead{},
+		children: map[string][]*vellum.FolderRead{},
+	}
+	for _, folder := range folders {
+		tree.folders[folder.Id] = folder
+		parentFolderId := ""
+		if folder.ParentFolderId != nil {
+			parentFolderId = *folder.ParentFolderId
+		}
+		tree.children[parentFolderId] = append(tree.children[parentFolderId], folder)
+	}
+	return tree
+}
+
+// resolve walks a slash-separated path of Folder names, starting from the
+// top level.
+func (t *folderTree) resolve(folderPath string) (*vellum.FolderRead, error) {
+	var folder *vellum.FolderRead
+	parentFolderId := ""
+	for _, name := range strings.Split(strings.Trim(folderPath, "/"), "/") {
+		if name == "" {
+			return nil, fmt.Errorf("folder path %q has an empty segment", folderPath)
+		}
+
+		var matches []*vellum.FolderRead
+		for _, child := range t.children[parentFolderId] {
+			if child.Name == name {
+				matches = append(matches, child)
+			}
+		}
+		switch len(matches) {
+		case 0:
+			return nil, fmt.Errorf("no folder named %q found while resolving %q", name, folderPath)
+		case 1:
+			folder = matches[0]
+			parentFolderId = folder.Id
+		default:
+			return nil, fmt.Errorf("%d folders named %q found while resolving %q", len(matches), name, folderPath)
+		}
+	}
+	return folder, nil
+}
+
+// path returns the slash-separated path of Folder names leading to the
+// Folder with the given ID.
+func (t *folderTree) path(id string) (string, error) {
+	var names []string
+	seen := map[string]struct{}{}
+	for id != "" {
+		folder, ok := t.folders[id]
+		if !ok {
+			return "", fmt.Errorf("no folder found with ID %q", id)
+		}
+		if _, ok := seen[id]; ok {
+			return "", fmt.Errorf("folder %q is nested in itself", id)
+		}
+		seen[id] = struct{}{}
+
+		names = append([]string{folder.Name}, names...)
+		id = ""
+		if folder.ParentFolderId != nil {
+			id = *folder.ParentFolderId
+		}
+	}
+	return strings.Join(names, "/"), nil
+}
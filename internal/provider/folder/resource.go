@@ -0,0 +1,259 @@
+package folder
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-vellum/internal/provider/timeout"
+	vellumclient "terraform-provider-vellum/internal/sdk/client"
+	"terraform-provider-vellum/internal/sdk/core"
+)
+
+var _ resource.ResourceWithConfigure = &FolderResource{}
+var _ resource.ResourceWithImportState = &FolderResource{}
+
+// folderNameRegexp rejects slashes, which separate the names of a Folder path.
+var folderNameRegexp = regexp.MustCompile(`^[^/]+$`)
+
+type FolderResource struct {
+	client *vellumclient.Client
+}
+
+func Resource() resource.Resource {
+	return &FolderResource{}
+}
+
+type TfFolderResourceModel struct {
+	Id             types.String   `tfsdk:"id"`
+	Name           types.String   `tfsdk:"name"`
+	ParentFolderId types.String   `tfsdk:"parent_folder_id"`
+	Created        types.String   `tfsdk:"created"`
+	Timeouts       timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *FolderResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_folder"
+}
+
+func (r *FolderResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Folder resource. Folders nest within each other and organize Document Indexes, ML Models, " +
+			"Deployments and Test Suites through their `folder_id` attribute.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The Folder's ID",
+				MarkdownDescription: "The Folder's ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The Folder's name. It may not contain a slash, so Folders can be looked up by path.",
+				MarkdownDescription: "The Folder's name. It may not contain a slash, so Folders can be looked up by path.",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 150),
+					stringvalidator.RegexMatches(folderNameRegexp, "must not contain a slash"),
+				},
+			},
+			"parent_folder_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "The ID of the Folder to nest this Folder in. Changing it moves the Folder in place, and unsetting it moves the Folder to the top level.",
+				MarkdownDescription: "The ID of the Folder to nest this Folder in. Changing it moves the Folder in place, and unsetting it moves the Folder to the top level.",
+			},
+			"created": schema.StringAttribute{
+				Computed:            true,
+				Description:         "When the Folder was created",
+				MarkdownDescription: "When the Folder was created",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeout.Block(ctx),
+		},
+	}
+}
+
+func (r *FolderResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*vellumclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *FolderResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var folderPlan *TfFolderResourceModel
+
+	diags := req.Plan.Get(ctx, &folderPlan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.WithResource(ctx, "vellum_folder "+folderPlan.Name.ValueString())
+
+	ctx, deadline, diags := timeout.Start(ctx, folderPlan.Timeouts, timeout.Create, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	folderRequest, d := NewVellumFolderCreateRequest(ctx, folderPlan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folder, err := r.client.Folders.Create(ctx, folderRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create folder, got error: %s", err))
+		return
+	}
+
+	folderModel, diagnostic := NewTfFolderModel(ctx, folderPlan, folder)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &folderModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *FolderResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var folderState TfFolderResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &folderState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, deadline, diags := timeout.Start(ctx, folderState.Timeouts, timeout.Read, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	folder, err := r.client.Folders.Retrieve(ctx, folderState.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read folder, got error: %s", err))
+		return
+	}
+
+	folderModel, diagnostic := NewTfFolderModel(ctx, &folderState, folder)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &folderModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *FolderResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var folderPlan *TfFolderResourceModel
+	var folderState *TfFolderResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &folderPlan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &folderState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.WithResource(ctx, "vellum_folder "+folderPlan.Name.ValueString())
+
+	ctx, deadline, diags := timeout.Start(ctx, folderPlan.Timeouts, timeout.Update, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	folderRequest, d := NewVellumFolderUpdateRequest(ctx, folderPlan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	folder, err := r.client.Folders.PartialUpdate(ctx, folderState.Id.ValueString(), folderRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("error during folder update", err.Error())
+		return
+	}
+
+	folderModel, diagnostic := NewTfFolderModel(ctx, folderPlan, folder)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &folderModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *FolderResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var folderState *TfFolderResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &folderState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.WithResource(ctx, "vellum_folder "+folderState.Name.ValueString())
+
+	ctx, deadline, diags := timeout.Start(ctx, folderState.Timeouts, timeout.Delete, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	err := r.client.Folders.Destroy(
+		ctx,
+		folderState.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("error when destroying the folder resource", err.Error())
+		return
+	}
+}
+
+func (r *FolderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,115 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+// newHTTPClient returns the HTTP client the Vellum API is called with,
+// configured by the provider's transport settings.
+func newHTTPClient(data *VellumProviderModel) (*http.Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	if proxyUrl := data.ProxyUrl.ValueString(); proxyUrl != "" {
+		parsed, err := url.Parse(proxyUrl)
+		if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			diags.AddAttributeError(
+				path.Root("proxy_url"),
+				"Invalid Proxy URL",
+				fmt.Sprintf("Expected an absolute URL such as http://proxy.example.com:3128, got: %q", proxyUrl),
+			)
+		} else {
+			transport.Proxy = http.ProxyURL(parsed)
+		}
+	}
+
+	caCertPem := data.CaCertPem.ValueString()
+	if caCertFile := data.CaCertFile.ValueString(); caCertFile != "" {
+		contents, err := os.ReadFile(caCertFile)
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("ca_cert_file"),
+				"Unable to Read CA Certificate",
+				fmt.Sprintf("Unable to read %s, got error: %s", caCertFile, err),
+			)
+		}
+		caCertPem = string(contents)
+	}
+	if caCertPem != "" {
+		// The CA is trusted on top of the system's, so a proxy's private CA
+		// doesn't stop Vellum's own certificate from being trusted.
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(caCertPem)) {
+			attribute := path.Root("ca_cert_pem")
+			if !data.CaCertFile.IsNull() {
+				attribute = path.Root("ca_cert_file")
+			}
+			diags.AddAttributeError(
+				attribute,
+				"Invalid CA Certificate",
+				"Expected one or more PEM encoded certificates, but none could be parsed.",
+			)
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	if clientCert, clientKey := data.ClientCert.ValueString(), data.ClientKey.ValueString(); clientCert != "" || clientKey != "" {
+		certificate, err := tls.X509KeyPair([]byte(clientCert), []byte(clientKey))
+		if err != nil {
+			diags.AddAttributeError(
+				path.Root("client_cert"),
+				"Invalid Client Certificate",
+				fmt.Sprintf("Unable to load the client certificate and key, got error: %s", err),
+			)
+		}
+		tlsConfig.Certificates = []tls.Certificate{certificate}
+	}
+
+	if data.InsecureSkipVerify.ValueBool() {
+		tlsConfig.InsecureSkipVerify = true
+		diags.AddAttributeWarning(
+			path.Root("insecure_skip_verify"),
+			"TLS Certificate Verification Disabled",
+			"The provider doesn't verify the certificate of the Vellum API, so its traffic, API key included, can be intercepted. "+
+				"Configure ca_cert_pem or ca_cert_file instead to trust a private CA.",
+		)
+	}
+
+	client := &http.Client{
+		Transport: transport,
+	}
+
+	if requestTimeout := data.RequestTimeout.ValueString(); requestTimeout != "" {
+		timeout, err := time.ParseDuration(requestTimeout)
+		if err != nil || timeout <= 0 {
+			diags.AddAttributeError(
+				path.Root("request_timeout"),
+				"Invalid Request Timeout",
+				fmt.Sprintf("Expected a positive duration such as 30s or 2m, got: %q", requestTimeout),
+			)
+		}
+		client.Timeout = timeout
+	}
+
+	return client, diags
+}
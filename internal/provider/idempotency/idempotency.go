@@ -0,0 +1,132 @@
+package idempotency
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+
+	"terraform-provider-vellum/internal/sdk/core"
+)
+
+// Key derives an idempotency key from the resource type, a nonce unique to
+// the create, and the request it creates its entity with.
+func Key(typeName string, nonce string, request interface{}) (string, error) {
+	body, err := json.Marshal(request)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(append([]byte(typeName+"\x00"+nonce+"\x00"), body...))
+	return "terraform-" + hex.EncodeToString(hash[:16]), nil
+}
+
+// Context returns a context that sends an idempotency key for the given
+// create request. Only pass it to the create call itself: every retry of
+// that call shares the key, but no other create does, even one with the same
+// configuration, such as recreating an entity that was just destroyed.
+// Creates retried by a later apply rely on adopting the entity instead.
+//
+// The nonce is drawn here rather than when planning, since the framework
+// doesn't pass planned private state to Create.
+func Context(ctx context.Context, typeName string, request interface{}) context.Context {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return ctx
+	}
+	key, err := Key(typeName, hex.EncodeToString(nonce), request)
+	if err != nil {
+		return ctx
+	}
+	return core.WithIdempotencyKey(ctx, key)
+}
+
+// IsConflict reports whether the error is Vellum refusing to create an
+// entity because its name is already taken.
+func IsConflict(err error) bool {
+	var apiErr *core.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	switch apiErr.StatusCode {
+	case http.StatusConflict:
+		return true
+	case http.StatusBadRequest:
+		return strings.Contains(strings.ToLower(apiErr.Error()), "already exists")
+	}
+	return false
+}
+
+// Mismatches lists the attributes an existing entity doesn't share with the
+// one that was planned.
+type Mismatches []string
+
+// Compare records the attribute as mismatched when the planned value, if
+// any, differs from the existing one.
+func (m *Mismatches) Compare(attribute string, planned *string, existing *string) {
+	if planned == nil {
+		return
+	}
+	if existing == nil || *planned != *existing {
+		existingValue := "null"
+		if existing != nil {
+			existingValue = fmt.Sprintf("%q", *existing)
+		}
+		*m = append(*m, fmt.Sprintf("%s is %s, not %q", attribute, existingValue, *planned))
+	}
+}
+
+// CompareJSON records the attribute as mismatched unless every value the
+// planned object sets, compared as JSON, is set to the same in the existing
+// one. Values only the existing object sets, such as defaults filled in by
+// Vellum, are ignored.
+func (m *Mismatches) CompareJSON(attribute string, planned interface{}, existing interface{}) {
+	plannedJSON, plannedErr := json.Marshal(planned)
+	existingJSON, existingErr := json.Marshal(existing)
+	var plannedValue, existingValue interface{}
+	if plannedErr == nil && existingErr == nil &&
+		json.Unmarshal(plannedJSON, &plannedValue) == nil &&
+		json.Unmarshal(existingJSON, &existingValue) == nil &&
+		jsonContains(existingValue, plannedValue) {
+		return
+	}
+	*m = append(*m, fmt.Sprintf("%s is %s, not %s", attribute, existingJSON, plannedJSON))
+}
+
+// jsonContains reports whether the decoded JSON value sets everything the
+// other one does.
+func jsonContains(value interface{}, other interface{}) bool {
+	otherObject, ok := other.(map[string]interface{})
+	if !ok {
+		return reflect.DeepEqual(value, other)
+	}
+	object, ok := value.(map[string]interface{})
+	if !ok {
+		return false
+	}
+	for key, otherField := range otherObject {
+		field, ok := object[key]
+		if !ok || !jsonContains(field, otherField) {
+			return false
+		}
+	}
+	return true
+}
+
+// Err returns an error explaining why the existing entity wasn't adopted, or
+// nil when every attribute matched.
+func (m Mismatches) Err(typeName string, name string, id string) error {
+	if len(m) == 0 {
+		return nil
+	}
+	return fmt.Errorf(
+		"%s %q already exists with different attributes (%s), so it wasn't adopted; "+
+			"import it with `terraform import %s.<name> %s` or choose another name",
+		typeName, name, strings.Join(m, "; "), typeName, id,
+	)
+}
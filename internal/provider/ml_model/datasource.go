@@ -5,11 +5,13 @@ import (
 	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	vellum "terraform-provider-vellum/internal/sdk"
 	vellumclient "terraform-provider-vellum/internal/sdk/client"
 )
 
@@ -31,6 +33,76 @@ type TfMLModelDataSourceModel struct {
 	HostedBy    types.String `tfsdk:"hosted_by"`
 	DevelopedBy types.String `tfsdk:"developed_by"`
 	Family      types.String `tfsdk:"family"`
+
+	ExecConfig      *TfMLModelDataSourceExecConfig      `tfsdk:"exec_config"`
+	ParameterConfig *TfMLModelDataSourceParameterConfig `tfsdk:"parameter_config"`
+	DisplayConfig   *TfMLModelDataSourceDisplayConfig   `tfsdk:"display_config"`
+}
+
+type TfMLModelDataSourceExecConfig struct {
+	ModelIdentifier types.String              `tfsdk:"model_identifier"`
+	BaseUrl         types.String              `tfsdk:"base_url"`
+	Features        types.List                `tfsdk:"features"`
+	Metadata        types.Map                 `tfsdk:"metadata"`
+	TokenizerConfig *TfMLModelTokenizerConfig `tfsdk:"tokenizer_config"`
+	RequestConfig   *TfMLModelRequestConfig   `tfsdk:"request_config"`
+	ResponseConfig  *TfMLModelResponseConfig  `tfsdk:"response_config"`
+}
+
+type TfMLModelTokenizerConfig struct {
+	Type types.String `tfsdk:"type"`
+	Name types.String `tfsdk:"name"`
+	Path types.String `tfsdk:"path"`
+}
+
+type TfMLModelRequestConfig struct {
+	Headers           types.Map    `tfsdk:"headers"`
+	AuthorizationType types.String `tfsdk:"authorization_type"`
+	BodyTemplate      types.String `tfsdk:"body_template"`
+}
+
+type TfMLModelResponseConfig struct {
+	ResultPath                types.String `tfsdk:"result_path"`
+	ResultExtractionRegex     types.String `tfsdk:"result_extraction_regex"`
+	ResultSubstitutionRegexes types.Map    `tfsdk:"result_substitution_regexes"`
+}
+
+type TfMLModelDataSourceParameterConfig struct {
+	Temperature      *TfMLModelNumberParameter  `tfsdk:"temperature"`
+	MaxTokens        *TfMLModelIntegerParameter `tfsdk:"max_tokens"`
+	TopP             *TfMLModelNumberParameter  `tfsdk:"top_p"`
+	TopK             *TfMLModelIntegerParameter `tfsdk:"top_k"`
+	FrequencyPenalty *TfMLModelNumberParameter  `tfsdk:"frequency_penalty"`
+	PresencePenalty  *TfMLModelNumberParameter  `tfsdk:"presence_penalty"`
+	Stop             types.String               `tfsdk:"stop"`
+	LogitBias        types.String               `tfsdk:"logit_bias"`
+	CustomParameters types.String               `tfsdk:"custom_parameters"`
+}
+
+type TfMLModelNumberParameter struct {
+	Minimum          types.Float64 `tfsdk:"minimum"`
+	Maximum          types.Float64 `tfsdk:"maximum"`
+	ExclusiveMinimum types.Bool    `tfsdk:"exclusive_minimum"`
+	ExclusiveMaximum types.Bool    `tfsdk:"exclusive_maximum"`
+	Default          types.Float64 `tfsdk:"default"`
+	Title            types.String  `tfsdk:"title"`
+	Description      types.String  `tfsdk:"description"`
+}
+
+type TfMLModelIntegerParameter struct {
+	Minimum          types.Int64  `tfsdk:"minimum"`
+	Maximum          types.Int64  `tfsdk:"maximum"`
+	ExclusiveMinimum types.Bool   `tfsdk:"exclusive_minimum"`
+	ExclusiveMaximum types.Bool   `tfsdk:"exclusive_maximum"`
+	Default          types.Int64  `tfsdk:"default"`
+	Title            types.String `tfsdk:"title"`
+	Description      types.String `tfsdk:"description"`
+}
+
+type TfMLModelDataSourceDisplayConfig struct {
+	Label       types.String `tfsdk:"label"`
+	Description types.String `tfsdk:"description"`
+	Tags        types.List   `tfsdk:"tags"`
 }
 
 func (d *MLModelDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -59,9 +131,10 @@ func (d *MLModelDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				MarkdownDescription: "The visibility of the ML Model.",
 			},
 			"hosted_by": schema.StringAttribute{
+				Optional:            true,
 				Computed:            true,
-				Description:         "The organization hosting the ML Model.",
-				MarkdownDescription: "The organization hosting the ML Model.",
+				Description:         "The organization hosting the ML Model. May be combined with other attributes to look up an ML Model when `id` and `name` aren't set.",
+				MarkdownDescription: "The organization hosting the ML Model. May be combined with other attributes to look up an ML Model when `id` and `name` aren't set.",
 				Validators: []validator.String{
 					stringvalidator.OneOf(
 						"ANTHROPIC",
@@ -85,9 +158,10 @@ func (d *MLModelDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				},
 			},
 			"developed_by": schema.StringAttribute{
+				Optional:            true,
 				Computed:            true,
-				Description:         "The organization that developed the ML Model.",
-				MarkdownDescription: "The organization that developed the ML Model.",
+				Description:         "The organization that developed the ML Model. May be combined with other attributes to look up an ML Model when `id` and `name` aren't set.",
+				MarkdownDescription: "The organization that developed the ML Model. May be combined with other attributes to look up an ML Model when `id` and `name` aren't set.",
 				Validators: []validator.String{
 					stringvalidator.OneOf(
 						"01_AI",
@@ -112,9 +186,10 @@ func (d *MLModelDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 				},
 			},
 			"family": schema.StringAttribute{
+				Optional:            true,
 				Computed:            true,
-				Description:         "The family of the ML Model.",
-				MarkdownDescription: "The family of the ML Model.",
+				Description:         "The family of the ML Model. May be combined with other attributes to look up an ML Model when `id` and `name` aren't set.",
+				MarkdownDescription: "The family of the ML Model. May be combined with other attributes to look up an ML Model when `id` and `name` aren't set.",
 				Validators: []validator.String{
 					stringvalidator.OneOf(
 						"CAPYBARA",
@@ -140,6 +215,191 @@ func (d *MLModelDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 					),
 				},
 			},
+			"exec_config": schema.SingleNestedAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Configuration for how to execute the ML Model.",
+				MarkdownDescription: "Configuration for how to execute the ML Model.",
+				Attributes: map[string]schema.Attribute{
+					"model_identifier": schema.StringAttribute{
+						Optional:            true,
+						Computed:            true,
+						Description:         "The identifier the hosting provider uses for the ML Model. May be combined with other attributes to look up an ML Model when `id` and `name` aren't set.",
+						MarkdownDescription: "The identifier the hosting provider uses for the ML Model. May be combined with other attributes to look up an ML Model when `id` and `name` aren't set.",
+					},
+					"base_url": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The base URL requests to the ML Model are sent to.",
+						MarkdownDescription: "The base URL requests to the ML Model are sent to.",
+					},
+					"features": schema.ListAttribute{
+						Computed:            true,
+						ElementType:         types.StringType,
+						Description:         "The features supported by the ML Model, e.g. `STREAMING_SUPPORT`.",
+						MarkdownDescription: "The features supported by the ML Model, e.g. `STREAMING_SUPPORT`.",
+					},
+					"metadata": schema.MapAttribute{
+						Computed:            true,
+						ElementType:         types.StringType,
+						Description:         "Arbitrary metadata attached to the ML Model.",
+						MarkdownDescription: "Arbitrary metadata attached to the ML Model.",
+					},
+					"tokenizer_config": schema.SingleNestedAttribute{
+						Computed:            true,
+						Description:         "The tokenizer used to count tokens for the ML Model.",
+						MarkdownDescription: "The tokenizer used to count tokens for the ML Model.",
+						Attributes: map[string]schema.Attribute{
+							"type": schema.StringAttribute{
+								Computed:            true,
+								Description:         "The tokenizer type, either `HUGGING_FACE` or `TIKTOKEN`.",
+								MarkdownDescription: "The tokenizer type, either `HUGGING_FACE` or `TIKTOKEN`.",
+							},
+							"name": schema.StringAttribute{
+								Computed:            true,
+								Description:         "The name of the tokenizer.",
+								MarkdownDescription: "The name of the tokenizer.",
+							},
+							"path": schema.StringAttribute{
+								Computed:            true,
+								Description:         "The path of a Hugging Face tokenizer, if any.",
+								MarkdownDescription: "The path of a Hugging Face tokenizer, if any.",
+							},
+						},
+					},
+					"request_config": schema.SingleNestedAttribute{
+						Computed:            true,
+						Description:         "How requests to the ML Model are built.",
+						MarkdownDescription: "How requests to the ML Model are built.",
+						Attributes: map[string]schema.Attribute{
+							"headers": schema.MapAttribute{
+								Computed:            true,
+								Sensitive:           true,
+								ElementType:         types.StringType,
+								Description:         "Headers sent with every request to the ML Model. Sensitive, since they usually carry its credentials.",
+								MarkdownDescription: "Headers sent with every request to the ML Model. Sensitive, since they usually carry its credentials.",
+							},
+							"authorization_type": schema.StringAttribute{
+								Computed:            true,
+								Description:         "How requests are authorized, either `BEARER_TOKEN` or `API_KEY`.",
+								MarkdownDescription: "How requests are authorized, either `BEARER_TOKEN` or `API_KEY`.",
+							},
+							"body_template": schema.StringAttribute{
+								Computed:            true,
+								Description:         "The template used to render the request body.",
+								MarkdownDescription: "The template used to render the request body.",
+							},
+						},
+					},
+					"response_config": schema.SingleNestedAttribute{
+						Computed:            true,
+						Description:         "How results are extracted from the ML Model's responses.",
+						MarkdownDescription: "How results are extracted from the ML Model's responses.",
+						Attributes: map[string]schema.Attribute{
+							"result_path": schema.StringAttribute{
+								Computed:            true,
+								Description:         "The path of the result within the response body.",
+								MarkdownDescription: "The path of the result within the response body.",
+							},
+							"result_extraction_regex": schema.StringAttribute{
+								Computed:            true,
+								Description:         "The regex used to extract the result.",
+								MarkdownDescription: "The regex used to extract the result.",
+							},
+							"result_substitution_regexes": schema.MapAttribute{
+								Computed:            true,
+								ElementType:         types.StringType,
+								Description:         "Regex substitutions applied to the result.",
+								MarkdownDescription: "Regex substitutions applied to the result.",
+							},
+						},
+					},
+				},
+			},
+			"parameter_config": schema.SingleNestedAttribute{
+				Computed:            true,
+				Description:         "Configuration for the ML Model's parameters.",
+				MarkdownDescription: "Configuration for the ML Model's parameters.",
+				Attributes: map[string]schema.Attribute{
+					"temperature":       numberParameterAttribute("The bounds of the `temperature` parameter."),
+					"max_tokens":        integerParameterAttribute("The bounds of the `max_tokens` parameter."),
+					"top_p":             numberParameterAttribute("The bounds of the `top_p` parameter."),
+					"top_k":             integerParameterAttribute("The bounds of the `top_k` parameter."),
+					"frequency_penalty": numberParameterAttribute("The bounds of the `frequency_penalty` parameter."),
+					"presence_penalty":  numberParameterAttribute("The bounds of the `presence_penalty` parameter."),
+					"stop": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The JSON-encoded schema of the `stop` parameter.",
+						MarkdownDescription: "The JSON-encoded schema of the `stop` parameter.",
+					},
+					"logit_bias": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The JSON-encoded schema of the `logit_bias` parameter.",
+						MarkdownDescription: "The JSON-encoded schema of the `logit_bias` parameter.",
+					},
+					"custom_parameters": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The JSON-encoded schemas of any custom parameters, keyed by name.",
+						MarkdownDescription: "The JSON-encoded schemas of any custom parameters, keyed by name.",
+					},
+				},
+			},
+			"display_config": schema.SingleNestedAttribute{
+				Computed:            true,
+				Description:         "Configuration for how to display the ML Model.",
+				MarkdownDescription: "Configuration for how to display the ML Model.",
+				Attributes: map[string]schema.Attribute{
+					"label": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The human-readable label of the ML Model.",
+						MarkdownDescription: "The human-readable label of the ML Model.",
+					},
+					"description": schema.StringAttribute{
+						Computed:            true,
+						Description:         "The description of the ML Model.",
+						MarkdownDescription: "The description of the ML Model.",
+					},
+					"tags": schema.ListAttribute{
+						Computed:            true,
+						ElementType:         types.StringType,
+						Description:         "The display tags of the ML Model, e.g. `CHAT` or `DEPRECATED`.",
+						MarkdownDescription: "The display tags of the ML Model, e.g. `CHAT` or `DEPRECATED`.",
+					},
+				},
+			},
+		},
+	}
+}
+
+func numberParameterAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Computed:            true,
+		Description:         description,
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"minimum":           schema.Float64Attribute{Computed: true},
+			"maximum":           schema.Float64Attribute{Computed: true},
+			"exclusive_minimum": schema.BoolAttribute{Computed: true},
+			"exclusive_maximum": schema.BoolAttribute{Computed: true},
+			"default":           schema.Float64Attribute{Computed: true},
+			"title":             schema.StringAttribute{Computed: true},
+			"description":       schema.StringAttribute{Computed: true},
+		},
+	}
+}
+
+func integerParameterAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Computed:            true,
+		Description:         description,
+		MarkdownDescription: description,
+		Attributes: map[string]schema.Attribute{
+			"minimum":           schema.Int64Attribute{Computed: true},
+			"maximum":           schema.Int64Attribute{Computed: true},
+			"exclusive_minimum": schema.BoolAttribute{Computed: true},
+			"exclusive_maximum": schema.BoolAttribute{Computed: true},
+			"default":           schema.Int64Attribute{Computed: true},
+			"title":             schema.StringAttribute{Computed: true},
+			"description":       schema.StringAttribute{Computed: true},
 		},
 	}
 }
@@ -172,18 +432,67 @@ func (d *MLModelDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
+	filter := newMLModelFilter(mLModelModel)
+
 	mlModelRetrieveParameter := mLModelModel.Name.ValueString()
 	if mlModelRetrieveParameter == "" {
 		mlModelRetrieveParameter = mLModelModel.Id.ValueString()
 	}
-	if mlModelRetrieveParameter == "" {
-		resp.Diagnostics.AddError("failed to read ML Model", "either `id` or `name` must be set")
-		return
-	}
 
-	MLModel, err := d.client.MLModels.Retrieve(ctx, mlModelRetrieveParameter)
-	if err != nil {
-		resp.Diagnostics.AddError("error getting ML Model information", err.Error())
+	var MLModel *vellum.MlModelRead
+	switch {
+	case mlModelRetrieveParameter != "":
+		MLModel, err = d.client.MLModels.Retrieve(ctx, mlModelRetrieveParameter)
+		if err != nil {
+			resp.Diagnostics.AddError("error getting ML Model information", err.Error())
+			return
+		}
+		if !filter.matches(MLModel) {
+			resp.Diagnostics.AddError(
+				"failed to read ML Model",
+				fmt.Sprintf("ML Model %q does not match the given %s", MLModel.Name, filter),
+			)
+			return
+		}
+	case !filter.isEmpty():
+		mlModels, err := d.client.MLModels.ListAll(ctx, &vellum.MlModelsListRequest{})
+		if err != nil {
+			resp.Diagnostics.AddError("error listing ML Models", err.Error())
+			return
+		}
+		var candidates []*vellum.MlModelRead
+		for _, mlModel := range mlModels {
+			if filter.matches(mlModel) {
+				candidates = append(candidates, mlModel)
+			}
+		}
+		switch len(candidates) {
+		case 0:
+			resp.Diagnostics.AddError("failed to read ML Model", fmt.Sprintf("no ML Model matches the given %s", filter))
+			return
+		case 1:
+			MLModel = candidates[0]
+		default:
+			var names []string
+			for _, candidate := range candidates {
+				names = append(names, fmt.Sprintf("%s (%s)", candidate.Name, candidate.Id))
+			}
+			resp.Diagnostics.AddError(
+				"failed to read ML Model",
+				fmt.Sprintf(
+					"%d ML Models match the given %s, narrow the lookup or set `name` or `id`:\n  - %s",
+					len(candidates),
+					filter,
+					strings.Join(names, "\n  - "),
+				),
+			)
+			return
+		}
+	default:
+		resp.Diagnostics.AddError(
+			"failed to read ML Model",
+			"either `id`, `name` or at least one of `family`, `hosted_by`, `developed_by` or `exec_config.model_identifier` must be set",
+		)
 		return
 	}
 
@@ -198,3 +507,61 @@ func (d *MLModelDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 }
+
+// mlModelFilter holds the attributes an ML Model can be looked up by when
+// neither its `id` nor `name` is known.
+type mlModelFilter struct {
+	family          string
+	hostedBy        string
+	developedBy     string
+	modelIdentifier string
+}
+
+func newMLModelFilter(mlModelModel *TfMLModelDataSourceModel) mlModelFilter {
+	filter := mlModelFilter{
+		family:      mlModelModel.Family.ValueString(),
+		hostedBy:    mlModelModel.HostedBy.ValueString(),
+		developedBy: mlModelModel.DevelopedBy.ValueString(),
+	}
+	if mlModelModel.ExecConfig != nil {
+		filter.modelIdentifier = mlModelModel.ExecConfig.ModelIdentifier.ValueString()
+	}
+	return filter
+}
+
+func (f mlModelFilter) isEmpty() bool {
+	return f == mlModelFilter{}
+}
+
+func (f mlModelFilter) matches(mlModel *vellum.MlModelRead) bool {
+	if f.family != "" && (mlModel.Family == nil || string(mlModel.Family.Value) != f.family) {
+		return false
+	}
+	if f.hostedBy != "" && string(mlModel.HostedBy) != f.hostedBy {
+		return false
+	}
+	if f.developedBy != "" && (mlModel.DevelopedBy == nil || string(mlModel.DevelopedBy.Value) != f.developedBy) {
+		return false
+	}
+	if f.modelIdentifier != "" && (mlModel.ExecConfig == nil || mlModel.ExecConfig.ModelIdentifier != f.modelIdentifier) {
+		return false
+	}
+	return true
+}
+
+func (f mlModelFilter) String() string {
+	var conditions []string
+	if f.family != "" {
+		conditions = append(conditions, fmt.Sprintf("family = %q", f.family))
+	}
+	if f.hostedBy != "" {
+		conditions = append(conditions, fmt.Sprintf("hosted_by = %q", f.hostedBy))
+	}
+	if f.developedBy != "" {
+		conditions = append(conditions, fmt.Sprintf("developed_by = %q", f.developedBy))
+	}
+	if f.modelIdentifier != "" {
+		conditions = append(conditions, fmt.Sprintf("exec_config.model_identifier = %q", f.modelIdentifier))
+	}
+	return "attributes (" + strings.Join(conditions, ", ") + ")"
+}
@@ -3,6 +3,7 @@ package ml_model
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -55,6 +56,7 @@ func NewVellumMLModelCreateRequest(ctx context.Context, mlModelModel *TfMLModelR
 func NewTfMLModelModel(ctx context.Context, model *TfMLModelResourceModel, mlModel *vellum.MlModelRead) (*TfMLModelResourceModel, diag.Diagnostics) {
 	mlModelModel := &TfMLModelResourceModel{
 		Id:          types.StringValue(mlModel.Id),
+		FolderId:    model.FolderId,
 		Name:        types.StringValue(mlModel.Name),
 		Visibility:  types.StringValue(string(*mlModel.Visibility)),
 		HostedBy:    types.StringValue(string(mlModel.HostedBy)),
@@ -84,6 +86,7 @@ func NewTfMLModelModel(ctx context.Context, model *TfMLModelResourceModel, mlMod
 				}(),
 			),
 		},
+		Timeouts: model.Timeouts,
 	}
 
 	return mlModelModel, nil
@@ -99,5 +102,181 @@ func NewTfMLModelDataSourceModel(ctx context.Context, mlModel *vellum.MlModelRea
 		Family:      types.StringValue(string(mlModel.Family.Value)),
 	}
 
+	if mlModel.ExecConfig != nil {
+		mlModelModel.ExecConfig = newTfMLModelDataSourceExecConfig(mlModel.ExecConfig)
+	}
+
+	if mlModel.ParameterConfig != nil {
+		parameterConfig, diags := newTfMLModelDataSourceParameterConfig(mlModel.ParameterConfig)
+		if diags.HasError() {
+			return nil, diags
+		}
+		mlModelModel.ParameterConfig = parameterConfig
+	}
+
+	if mlModel.DisplayConfig != nil {
+		mlModelModel.DisplayConfig = &TfMLModelDataSourceDisplayConfig{
+			Label:       types.StringValue(mlModel.DisplayConfig.Label),
+			Description: types.StringValue(mlModel.DisplayConfig.Description),
+			Tags: types.ListValueMust(
+				types.StringType,
+				func() []attr.Value {
+					tags := []attr.Value{}
+					for _, tag := range mlModel.DisplayConfig.Tags {
+						tags = append(tags, types.StringValue(string(tag.Value)))
+					}
+					return tags
+				}(),
+			),
+		}
+	}
+
 	return mlModelModel, nil
 }
+
+func newTfMLModelDataSourceExecConfig(execConfig *vellum.MlModelExecConfig) *TfMLModelDataSourceExecConfig {
+	execConfigModel := &TfMLModelDataSourceExecConfig{
+		ModelIdentifier: types.StringValue(execConfig.ModelIdentifier),
+		BaseUrl:         types.StringValue(execConfig.BaseUrl),
+		Features: types.ListValueMust(
+			types.StringType,
+			func() []attr.Value {
+				features := []attr.Value{}
+				for _, feature := range execConfig.Features {
+					features = append(features, types.StringValue(string(feature)))
+				}
+				return features
+			}(),
+		),
+		Metadata: types.MapValueMust(
+			types.StringType,
+			func() map[string]attr.Value {
+				metadata := map[string]attr.Value{}
+				for key, value := range execConfig.Metadata {
+					metadata[key] = types.StringValue(value)
+				}
+				return metadata
+			}(),
+		),
+	}
+
+	if tokenizerConfig := execConfig.TokenizerConfig; tokenizerConfig != nil {
+		tokenizerConfigModel := &TfMLModelTokenizerConfig{
+			Type: types.StringValue(tokenizerConfig.Type),
+			Name: types.StringNull(),
+			Path: types.StringNull(),
+		}
+		switch {
+		case tokenizerConfig.HuggingFace != nil:
+			tokenizerConfigModel.Name = types.StringValue(tokenizerConfig.HuggingFace.Name)
+			tokenizerConfigModel.Path = types.StringPointerValue(tokenizerConfig.HuggingFace.Path)
+		case tokenizerConfig.Tiktoken != nil:
+			tokenizerConfigModel.Name = types.StringValue(tokenizerConfig.Tiktoken.Name)
+		}
+		execConfigModel.TokenizerConfig = tokenizerConfigModel
+	}
+
+	if requestConfig := execConfig.RequestConfig; requestConfig != nil {
+		requestConfigModel := &TfMLModelRequestConfig{
+			Headers:           newTfStringPointerMap(requestConfig.Headers),
+			AuthorizationType: types.StringNull(),
+			BodyTemplate:      types.StringPointerValue(requestConfig.BodyTemplate),
+		}
+		if requestConfig.Authorization != nil {
+			requestConfigModel.AuthorizationType = types.StringValue(string(requestConfig.Authorization.Type))
+		}
+		execConfigModel.RequestConfig = requestConfigModel
+	}
+
+	if responseConfig := execConfig.ResponseConfig; responseConfig != nil {
+		execConfigModel.ResponseConfig = &TfMLModelResponseConfig{
+			ResultPath:                types.StringPointerValue(responseConfig.ResultPath),
+			ResultExtractionRegex:     types.StringPointerValue(responseConfig.ResultExtractionRegex),
+			ResultSubstitutionRegexes: newTfStringPointerMap(responseConfig.ResultSubstitutionRegexes),
+		}
+	}
+
+	return execConfigModel
+}
+
+func newTfMLModelDataSourceParameterConfig(parameterConfig *vellum.MlModelParameterConfig) (*TfMLModelDataSourceParameterConfig, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	parameterConfigModel := &TfMLModelDataSourceParameterConfig{
+		Temperature:      newTfMLModelNumberParameter(parameterConfig.Temperature),
+		MaxTokens:        newTfMLModelIntegerParameter(parameterConfig.MaxTokens),
+		TopP:             newTfMLModelNumberParameter(parameterConfig.TopP),
+		TopK:             newTfMLModelIntegerParameter(parameterConfig.TopK),
+		FrequencyPenalty: newTfMLModelNumberParameter(parameterConfig.FrequencyPenalty),
+		PresencePenalty:  newTfMLModelNumberParameter(parameterConfig.PresencePenalty),
+		Stop:             types.StringNull(),
+		LogitBias:        types.StringNull(),
+		CustomParameters: types.StringNull(),
+	}
+
+	if parameterConfig.Stop != nil {
+		parameterConfigModel.Stop = newTfJSONString(parameterConfig.Stop, "stop", &diags)
+	}
+	if parameterConfig.LogitBias != nil {
+		parameterConfigModel.LogitBias = newTfJSONString(parameterConfig.LogitBias, "logit_bias", &diags)
+	}
+	if len(parameterConfig.CustomParameters) > 0 {
+		parameterConfigModel.CustomParameters = newTfJSONString(parameterConfig.CustomParameters, "custom_parameters", &diags)
+	}
+
+	return parameterConfigModel, diags
+}
+
+func newTfMLModelNumberParameter(property *vellum.OpenApiNumberProperty) *TfMLModelNumberParameter {
+	if property == nil {
+		return nil
+	}
+	return &TfMLModelNumberParameter{
+		Minimum:          types.Float64PointerValue(property.Minimum),
+		Maximum:          types.Float64PointerValue(property.Maximum),
+		ExclusiveMinimum: types.BoolPointerValue(property.ExclusiveMinimum),
+		ExclusiveMaximum: types.BoolPointerValue(property.ExclusiveMaximum),
+		Default:          types.Float64PointerValue(property.Default),
+		Title:            types.StringPointerValue(property.Title),
+		Description:      types.StringPointerValue(property.Description),
+	}
+}
+
+func newTfMLModelIntegerParameter(property *vellum.OpenApiIntegerProperty) *TfMLModelIntegerParameter {
+	if property == nil {
+		return nil
+	}
+	return &TfMLModelIntegerParameter{
+		Minimum:          newTfInt64PointerValue(property.Minimum),
+		Maximum:          newTfInt64PointerValue(property.Maximum),
+		ExclusiveMinimum: types.BoolPointerValue(property.ExclusiveMinimum),
+		ExclusiveMaximum: types.BoolPointerValue(property.ExclusiveMaximum),
+		Default:          newTfInt64PointerValue(property.Default),
+		Title:            types.StringPointerValue(property.Title),
+		Description:      types.StringPointerValue(property.Description),
+	}
+}
+
+func newTfJSONString(value interface{}, name string, diags *diag.Diagnostics) types.String {
+	bytes, err := json.Marshal(value)
+	if err != nil {
+		diags.AddError("failed to encode ML Model parameter config", fmt.Sprintf("unable to encode `%s`: %s", name, err))
+		return types.StringNull()
+	}
+	return types.StringValue(string(bytes))
+}
+
+func newTfInt64PointerValue(value *int) types.Int64 {
+	if value == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(int64(*value))
+}
+
+func newTfStringPointerMap(values map[string]*string) types.Map {
+	elements := map[string]attr.Value{}
+	for key, value := range values {
+		elements[key] = types.StringPointerValue(value)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
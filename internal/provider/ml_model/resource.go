@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -15,8 +16,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
+	"terraform-provider-vellum/internal/provider/folder"
+	"terraform-provider-vellum/internal/provider/timeout"
 	vellum "terraform-provider-vellum/internal/sdk"
 	vellumclient "terraform-provider-vellum/internal/sdk/client"
+	"terraform-provider-vellum/internal/sdk/core"
 )
 
 var _ resource.ResourceWithConfigure = &MLModelResource{}
@@ -40,11 +44,13 @@ type TfMLModelExecConfig struct {
 type TfMLModelResourceModel struct {
 	Id          types.String        `tfsdk:"id"`
 	Name        types.String        `tfsdk:"name"`
+	FolderId    types.String        `tfsdk:"folder_id"`
 	Visibility  types.String        `tfsdk:"visibility"`
 	HostedBy    types.String        `tfsdk:"hosted_by"`
 	DevelopedBy types.String        `tfsdk:"developed_by"`
 	Family      types.String        `tfsdk:"family"`
 	ExecConfig  TfMLModelExecConfig `tfsdk:"exec_config"`
+	Timeouts    timeouts.Value      `tfsdk:"timeouts"`
 }
 
 func (r *MLModelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -70,6 +76,7 @@ func (r *MLModelResource) Schema(ctx context.Context, req resource.SchemaRequest
 					stringvalidator.LengthBetween(1, 150),
 				},
 			},
+			"folder_id": folder.EntityFolderIdAttribute("ML Model"),
 			"visibility": schema.StringAttribute{
 				Description:         "The visibility of the ML Model.",
 				MarkdownDescription: "The visibility of the ML Model.",
@@ -194,6 +201,10 @@ func (r *MLModelResource) Schema(ctx context.Context, req resource.SchemaRequest
 				},
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeout.Block(ctx),
+		},
 	}
 }
 
@@ -226,6 +237,15 @@ func (r *MLModelResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	ctx = core.WithResource(ctx, "vellum_ml_model "+mlModelPlan.Name.ValueString())
+
+	ctx, deadline, diags := timeout.Start(ctx, mlModelPlan.Timeouts, timeout.Create, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
 	mlModelRequest, d := NewVellumMLModelCreateRequest(ctx, mlModelPlan)
 	resp.Diagnostics.Append(d...)
 	if resp.Diagnostics.HasError() {
@@ -238,6 +258,15 @@ func (r *MLModelResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	if err := folder.MoveEntity(ctx, r.client, mlModel.Id, mlModelPlan.FolderId, types.StringNull(), folder.MLModelRootFolderId); err != nil {
+		// The ML Model exists, so keep it in state outside of any Folder.
+		mlModelPlan.FolderId = types.StringNull()
+		mlModelModel, _ := NewTfMLModelModel(ctx, mlModelPlan, mlModel)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &mlModelModel)...)
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to move ML Model into folder, got error: %s", err))
+		return
+	}
+
 	mlModelModel, diagnostic := NewTfMLModelModel(ctx, mlModelPlan, mlModel)
 	resp.Diagnostics.Append(diagnostic...)
 	if resp.Diagnostics.HasError() {
@@ -259,6 +288,13 @@ func (r *MLModelResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	ctx, deadline, diags := timeout.Start(ctx, mlModelState.Timeouts, timeout.Read, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
 	mlModel, err := r.client.MLModels.Retrieve(ctx, mlModelState.Id.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read ML Model, got error: %s", err))
@@ -287,6 +323,15 @@ func (r *MLModelResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	ctx = core.WithResource(ctx, "vellum_ml_model "+mlModelPlan.Name.ValueString())
+
+	ctx, deadline, diags := timeout.Start(ctx, mlModelPlan.Timeouts, timeout.Update, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
 	id := mlModelState.Id.ValueString()
 
 	var visibility *vellum.VisibilityEnum
@@ -306,6 +351,11 @@ func (r *MLModelResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	if err := folder.MoveEntity(ctx, r.client, mlModel.Id, mlModelPlan.FolderId, mlModelState.FolderId, folder.MLModelRootFolderId); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to move ML Model into folder, got error: %s", err))
+		return
+	}
+
 	mlModelModel, diagnostic := NewTfMLModelModel(ctx, mlModelPlan, mlModel)
 	resp.Diagnostics.Append(diagnostic...)
 	if resp.Diagnostics.HasError() {
@@ -326,6 +376,15 @@ func (r *MLModelResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	ctx = core.WithResource(ctx, "vellum_ml_model "+mlModelState.Name.ValueString())
+
+	ctx, deadline, diags := timeout.Start(ctx, mlModelState.Timeouts, timeout.Delete, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
 	id := mlModelState.Id.ValueString()
 	visibility := vellum.VisibilityEnum("DISABLED")
 
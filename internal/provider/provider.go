@@ -5,18 +5,38 @@ package provider
 
 import (
 	"context"
+	"fmt"
 	"os"
+	"strconv"
+	"terraform-provider-vellum/internal/provider/deployment"
+	"terraform-provider-vellum/internal/provider/deployment_release_tag"
+	"terraform-provider-vellum/internal/provider/document"
 	"terraform-provider-vellum/internal/provider/document_index"
+	"terraform-provider-vellum/internal/provider/document_index_search"
+	"terraform-provider-vellum/internal/provider/document_index_sync"
+	"terraform-provider-vellum/internal/provider/documents"
+	"terraform-provider-vellum/internal/provider/folder"
 	"terraform-provider-vellum/internal/provider/ml_model"
+	"terraform-provider-vellum/internal/provider/test_suite"
+	"terraform-provider-vellum/internal/provider/test_suite_test_case"
+	"terraform-provider-vellum/internal/provider/workflow_deployment"
+	"terraform-provider-vellum/internal/provider/workflow_release_tag"
+	"terraform-provider-vellum/internal/provider/workspace"
+	"terraform-provider-vellum/internal/provider/workspace_secret"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	vellumclient "terraform-provider-vellum/internal/sdk/client"
+	"terraform-provider-vellum/internal/sdk/core"
 )
 
 // Ensure VellumProvider satisfies various provider interfaces.
@@ -33,8 +53,21 @@ type VellumProvider struct {
 
 // VellumProviderModel describes the provider data model.
 type VellumProviderModel struct {
-	APIKey  types.String `tfsdk:"api_key"`
-	BaseUrl types.String `tfsdk:"base_url"`
+	APIKey                types.String `tfsdk:"api_key"`
+	BaseUrl               types.String `tfsdk:"base_url"`
+	Environment           types.String `tfsdk:"environment"`
+	PathPrefix            types.String `tfsdk:"path_prefix"`
+	ExpectedWorkspaceId   types.String `tfsdk:"expected_workspace_id"`
+	ExpectedWorkspaceName types.String `tfsdk:"expected_workspace_name"`
+	ReadOnly              types.Bool   `tfsdk:"read_only"`
+	ProxyUrl              types.String `tfsdk:"proxy_url"`
+	CaCertPem             types.String `tfsdk:"ca_cert_pem"`
+	CaCertFile            types.String `tfsdk:"ca_cert_file"`
+	ClientCert            types.String `tfsdk:"client_cert"`
+	ClientKey             types.String `tfsdk:"client_key"`
+	InsecureSkipVerify    types.Bool   `tfsdk:"insecure_skip_verify"`
+	RequestTimeout        types.String `tfsdk:"request_timeout"`
+	DisableReadCache      types.Bool   `tfsdk:"disable_read_cache"`
 }
 
 func (p *VellumProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -50,8 +83,83 @@ func (p *VellumProvider) Schema(ctx context.Context, req provider.SchemaRequest,
 				Optional:            true,
 			},
 			"base_url": schema.StringAttribute{
-				MarkdownDescription: "Base URL to use with the Vellum API",
+				MarkdownDescription: "Base URL of a custom Vellum API, such as a self-hosted Vellum at `https://vellum.example.com`, serving every Vellum service. " +
+					"Conflicts with `environment`, and takes precedence over the `VELLUM_BASE_URL` environment variable.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("environment")),
+				},
+			},
+			"environment": schema.StringAttribute{
+				MarkdownDescription: "The Vellum environment to call. Only `production` is available; set `base_url` to call any other Vellum. " +
+					"Takes precedence over the `VELLUM_BASE_URL` environment variable. Defaults to `production`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("production"),
+				},
+			},
+			"path_prefix": schema.StringAttribute{
+				MarkdownDescription: "Path the Vellum API is served under, such as `/vellum` behind a reverse proxy. It's appended to the base URL of every Vellum service.",
+				Optional:            true,
+			},
+			"expected_workspace_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace the API key must authenticate against. " +
+					"The provider refuses to configure when the API key belongs to any other workspace.",
+				Optional: true,
+			},
+			"expected_workspace_name": schema.StringAttribute{
+				MarkdownDescription: "The name of the workspace the API key must authenticate against. " +
+					"The provider refuses to configure when the API key belongs to any other workspace.",
+				Optional: true,
+			},
+			"read_only": schema.BoolAttribute{
+				MarkdownDescription: "Refuse every request that would create, update or delete anything, so that refreshes and data sources still work but an `apply` fails before changing anything. " +
+					"Can also be enabled with the `VELLUM_READ_ONLY` environment variable.",
+				Optional: true,
+			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the proxy to reach the Vellum API through, such as `http://proxy.example.com:3128`. " +
+					"Defaults to the proxy set by the `HTTPS_PROXY` and `NO_PROXY` environment variables, if any.",
+				Optional: true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificates to trust on top of the system's, such as the private CA of a proxy or of a self-hosted Vellum.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_cert_file")),
+				},
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file of PEM encoded CA certificates to trust on top of the system's.",
+				Optional:            true,
+			},
+			"client_cert": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate to authenticate with over mutual TLS.",
 				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key")),
+				},
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded private key of `client_cert`.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_cert")),
+				},
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip verifying the certificate of the Vellum API. Only meant for testing, as it allows the API key to be intercepted.",
+				Optional:            true,
+			},
+			"request_timeout": schema.StringAttribute{
+				MarkdownDescription: "How long a single request to the Vellum API may take, as a duration such as `30s` or `2m`. Defaults to no limit.",
+				Optional:            true,
+			},
+			"disable_read_cache": schema.BoolAttribute{
+				MarkdownDescription: "Send every read to the Vellum API. By default, concurrent reads of the same entity are sent once, " +
+					"and their response is reused for a few seconds, until the provider modifies an entity of the same kind.",
+				Optional: true,
 			},
 		},
 	}
@@ -66,32 +174,126 @@ func (p *VellumProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
-	baseUrl := os.Getenv("VELLUM_BASE_URL")
-	if baseUrl == "" {
-		baseUrl = data.BaseUrl.ValueString()
+	environment, diags := newEnvironment(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Either the attribute or the environment variable is enough to make the
+	// provider read-only, so neither can be used to lift the other.
+	readOnly := data.ReadOnly.ValueBool()
+	if value := os.Getenv("VELLUM_READ_ONLY"); value != "" {
+		envReadOnly, err := strconv.ParseBool(value)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Invalid VELLUM_READ_ONLY Environment Variable",
+				fmt.Sprintf("Expected VELLUM_READ_ONLY to be true or false, got: %q", value),
+			)
+			return
+		}
+		readOnly = readOnly || envReadOnly
+	}
+
+	httpClient, diags := newHTTPClient(&data)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clientOptions := []core.ClientOption{
+		vellumclient.WithApiKey(os.Getenv("VELLUM_API_KEY")),
+		vellumclient.WithEnvironment(environment),
+		vellumclient.WithHTTPClient(httpClient),
+		vellumclient.WithReadOnly(readOnly),
+		// Requests are logged under TF_LOG=DEBUG, and their bodies under
+		// TF_LOG=TRACE, or per TF_LOG_PROVIDER_VELLUM_API.
+		vellumclient.WithLogging(true),
+	}
+	if !data.DisableReadCache.ValueBool() {
+		// Every data source and resource of this provider instance shares
+		// the cache, so the same entity is only read once per run.
+		clientOptions = append(clientOptions, vellumclient.WithCache(core.NewCache(core.DefaultCacheTTL)))
+	}
+	client := vellumclient.NewClient(clientOptions...)
+
+	resp.Diagnostics.Append(checkExpectedWorkspace(ctx, client, &data)...)
+	if resp.Diagnostics.HasError() {
+		return
 	}
 
-	client := vellumclient.NewClient(
-		vellumclient.WithApiKeyAndBaseUrl(
-			os.Getenv("VELLUM_API_KEY"),
-			baseUrl,
-		),
-	)
 	resp.DataSourceData = client
 	resp.ResourceData = client
 }
 
+// checkExpectedWorkspace makes sure the API key authenticates against the
+// expected workspace, when one is configured, so an API key meant for
+// another workspace can't be applied against by mistake.
+func checkExpectedWorkspace(ctx context.Context, client *vellumclient.Client, data *VellumProviderModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Unknown values are checked once they're known, when the provider is
+	// configured again for apply.
+	expectId := !data.ExpectedWorkspaceId.IsNull() && !data.ExpectedWorkspaceId.IsUnknown()
+	expectName := !data.ExpectedWorkspaceName.IsNull() && !data.ExpectedWorkspaceName.IsUnknown()
+	if !expectId && !expectName {
+		return diags
+	}
+
+	workspace, err := client.Workspaces.RetrieveCurrent(ctx)
+	if err != nil {
+		diags.AddError(
+			"Unable to Verify Vellum Workspace",
+			fmt.Sprintf("Unable to read the workspace the API key authenticates against, got error: %s", err),
+		)
+		return diags
+	}
+
+	if expectId && workspace.Id != data.ExpectedWorkspaceId.ValueString() {
+		diags.AddAttributeError(
+			path.Root("expected_workspace_id"),
+			"Unexpected Vellum Workspace",
+			fmt.Sprintf("The API key authenticates against workspace %q (%s), but expected_workspace_id is %s.", workspace.Name, workspace.Id, data.ExpectedWorkspaceId.ValueString()),
+		)
+	}
+	if expectName && workspace.Name != data.ExpectedWorkspaceName.ValueString() {
+		diags.AddAttributeError(
+			path.Root("expected_workspace_name"),
+			"Unexpected Vellum Workspace",
+			fmt.Sprintf("The API key authenticates against workspace %q (%s), but expected_workspace_name is %q.", workspace.Name, workspace.Id, data.ExpectedWorkspaceName.ValueString()),
+		)
+	}
+
+	return diags
+}
+
 func (p *VellumProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		deployment.Resource,
+		deployment_release_tag.Resource,
+		document.Resource,
 		document_index.Resource,
+		document_index_sync.Resource,
+		folder.Resource,
 		ml_model.Resource,
+		test_suite.Resource,
+		test_suite_test_case.Resource,
+		workflow_release_tag.Resource,
+		workspace_secret.Resource,
 	}
 }
 
 func (p *VellumProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		deployment.DataSource,
+		deployment_release_tag.DataSource,
 		document_index.DataSource,
+		document_index_search.DataSource,
+		documents.DataSource,
+		folder.DataSource,
 		ml_model.DataSource,
+		workflow_deployment.DataSource,
+		workspace.DataSource,
 	}
 }
 
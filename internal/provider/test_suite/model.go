@@ -0,0 +1,105 @@
+package test_suite
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	vellum "terraform-provider-vellum/internal/sdk"
+)
+
+func NewVellumTestSuiteCreateRequest(ctx context.Context, testSuiteModel *TfTestSuiteResourceModel) (*vellum.TestSuiteCreateRequest, diag.Diagnostics) {
+	request := vellum.TestSuiteCreateRequest{
+		Name:                testSuiteModel.Name.ValueString(),
+		Label:               testSuiteModel.Label.ValueStringPointer(),
+		InputVariables:      newVellumTestSuiteVariables(testSuiteModel.InputVariables),
+		EvaluationVariables: newVellumTestSuiteVariables(testSuiteModel.EvaluationVariables),
+	}
+
+	return &request, nil
+}
+
+func NewVellumTestSuiteUpdateRequest(ctx context.Context, testSuiteModel *TfTestSuiteResourceModel) (*vellum.PatchedTestSuiteUpdateRequest, diag.Diagnostics) {
+	request := vellum.PatchedTestSuiteUpdateRequest{
+		Label:               testSuiteModel.Label.ValueStringPointer(),
+		InputVariables:      newVellumTestSuiteVariables(testSuiteModel.InputVariables),
+		EvaluationVariables: newVellumTestSuiteVariables(testSuiteModel.EvaluationVariables),
+	}
+	if request.EvaluationVariables == nil {
+		// An empty list clears the evaluation variables, where a missing one
+		// would leave them as they are.
+		request.EvaluationVariables = []*vellum.TestSuiteVariableRequest{}
+	}
+
+	return &request, nil
+}
+
+func newVellumTestSuiteVariables(variables []TfTestSuiteVariable) []*vellum.TestSuiteVariableRequest {
+	var requests []*vellum.TestSuiteVariableRequest
+	for _, variable := range variables {
+		requests = append(requests, &vellum.TestSuiteVariableRequest{
+			Key:      variable.Key.ValueString(),
+			Type:     vellum.VellumVariableType(variable.Type.ValueString()),
+			Required: variable.Required.ValueBoolPointer(),
+		})
+	}
+	return requests
+}
+
+// NewTfTestSuiteModel carries test_cases_file over from the given model,
+// along with the hashes of the Test Cases it manages.
+func NewTfTestSuiteModel(ctx context.Context, model *TfTestSuiteResourceModel, testSuite *vellum.TestSuiteRead, testCaseHashes map[string]string) (*TfTestSuiteResourceModel, diag.Diagnostics) {
+	testSuiteModel := &TfTestSuiteResourceModel{
+		Id:                  types.StringValue(testSuite.Id),
+		Name:                types.StringValue(testSuite.Name),
+		Label:               types.StringValue(testSuite.Label),
+		FolderId:            model.FolderId,
+		InputVariables:      newTfTestSuiteVariables(testSuite.InputVariables),
+		EvaluationVariables: newTfTestSuiteVariables(testSuite.EvaluationVariables),
+		TestCasesFile:       model.TestCasesFile,
+		TestCaseHashes:      types.MapNull(types.StringType),
+		Timeouts:            model.Timeouts,
+	}
+	if testSuiteModel.EvaluationVariables == nil && model.EvaluationVariables != nil {
+		testSuiteModel.EvaluationVariables = []TfTestSuiteVariable{}
+	}
+
+	var diags diag.Diagnostics
+	if testCaseHashes != nil {
+		testSuiteModel.TestCaseHashes, diags = types.MapValueFrom(ctx, types.StringType, testCaseHashes)
+	}
+
+	return testSuiteModel, diags
+}
+
+func newTfTestSuiteVariables(variables []*vellum.VellumVariable) []TfTestSuiteVariable {
+	var testSuiteVariables []TfTestSuiteVariable
+	for _, variable := range variables {
+		required := false
+		if variable.Required != nil {
+			required = *variable.Required
+		}
+		testSuiteVariables = append(testSuiteVariables, TfTestSuiteVariable{
+			Key:      types.StringValue(variable.Key),
+			Type:     types.StringValue(string(variable.Type)),
+			Required: types.BoolValue(required),
+		})
+	}
+	return testSuiteVariables
+}
+
+// newTestCaseVariables returns the types of the model's variables.
+func newTestCaseVariables(testSuiteModel *TfTestSuiteResourceModel) *testCaseVariables {
+	variables := &testCaseVariables{
+		inputs:      map[string]vellum.VellumVariableType{},
+		evaluations: map[string]vellum.VellumVariableType{},
+	}
+	for _, variable := range testSuiteModel.InputVariables {
+		variables.inputs[variable.Key.ValueString()] = vellum.VellumVariableType(variable.Type.ValueString())
+	}
+	for _, variable := range testSuiteModel.EvaluationVariables {
+		variables.evaluations[variable.Key.ValueString()] = vellum.VellumVariableType(variable.Type.ValueString())
+	}
+	return variables
+}
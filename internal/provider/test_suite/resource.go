@@ -0,0 +1,532 @@
+package test_suite
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"terraform-provider-vellum/internal/provider/folder"
+	"terraform-provider-vellum/internal/provider/idempotency"
+	"terraform-provider-vellum/internal/provider/timeout"
+	vellum "terraform-provider-vellum/internal/sdk"
+	vellumclient "terraform-provider-vellum/internal/sdk/client"
+	"terraform-provider-vellum/internal/sdk/core"
+)
+
+var _ resource.ResourceWithConfigure = &TestSuiteResource{}
+var _ resource.ResourceWithImportState = &TestSuiteResource{}
+var _ resource.ResourceWithModifyPlan = &TestSuiteResource{}
+
+type TestSuiteResource struct {
+	client *vellumclient.Client
+}
+
+func Resource() resource.Resource {
+	return &TestSuiteResource{}
+}
+
+type TfTestSuiteResourceModel struct {
+	Id                  types.String          `tfsdk:"id"`
+	Name                types.String          `tfsdk:"name"`
+	Label               types.String          `tfsdk:"label"`
+	FolderId            types.String          `tfsdk:"folder_id"`
+	InputVariables      []TfTestSuiteVariable `tfsdk:"input_variables"`
+	EvaluationVariables []TfTestSuiteVariable `tfsdk:"evaluation_variables"`
+	TestCasesFile       types.String          `tfsdk:"test_cases_file"`
+	TestCaseHashes      types.Map             `tfsdk:"test_case_hashes"`
+	Timeouts            timeouts.Value        `tfsdk:"timeouts"`
+}
+
+type TfTestSuiteVariable struct {
+	Key      types.String `tfsdk:"key"`
+	Type     types.String `tfsdk:"type"`
+	Required types.Bool   `tfsdk:"required"`
+}
+
+func (r *TestSuiteResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_test_suite"
+}
+
+func (r *TestSuiteResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Test Suite resource. Defines the typed input and expected output variables its Test Cases provide values for. " +
+			"Test Cases can be managed one at a time with `vellum_test_suite_test_case`, or in bulk from a local file with `test_cases_file`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The Test Suite's ID",
+				MarkdownDescription: "The Test Suite's ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "A name that uniquely identifies this Test Suite within its workspace",
+				MarkdownDescription: "A name that uniquely identifies this Test Suite within its workspace",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 150),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "A human-readable label for the Test Suite. Defaults to its name.",
+				MarkdownDescription: "A human-readable label for the Test Suite. Defaults to its name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"folder_id": folder.EntityFolderIdAttribute("Test Suite"),
+			"input_variables": schema.ListNestedAttribute{
+				Required:            true,
+				Description:         "The variables every Test Case provides input values for",
+				MarkdownDescription: "The variables every Test Case provides input values for",
+				NestedObject:        testSuiteVariableAttribute(),
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"evaluation_variables": schema.ListNestedAttribute{
+				Optional:            true,
+				Description:         "The variables every Test Case provides expected output values for",
+				MarkdownDescription: "The variables every Test Case provides expected output values for",
+				NestedObject:        testSuiteVariableAttribute(),
+			},
+			"test_cases_file": schema.StringAttribute{
+				Optional: true,
+				Description: "A local .jsonl or .csv file of Test Cases to keep in sync with the Test Suite. " +
+					"Only new and changed rows are uploaded, and Test Cases whose rows were removed are deleted.",
+				MarkdownDescription: "A local `.jsonl` or `.csv` file of Test Cases to keep in sync with the Test Suite. " +
+					"Every row needs an `external_id`, which matches it to its Test Case. " +
+					"JSONL lines are objects with `external_id`, `label`, `input_values` and `evaluation_values` keys. " +
+					"CSV files have a header row with `external_id`, `label`, and an `input.<key>` or `evaluation.<key>` column per variable; " +
+					"empty cells are left out and non-`STRING` cells are parsed as JSON. " +
+					"Only new and changed rows are uploaded, and Test Cases whose rows were removed are deleted. " +
+					"Test Cases that weren't uploaded from the file are never touched.",
+			},
+			"test_case_hashes": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				Description:         "The hash of every Test Case managed by test_cases_file, keyed by external ID",
+				MarkdownDescription: "The hash of every Test Case managed by `test_cases_file`, keyed by external ID",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeout.Block(ctx),
+		},
+	}
+}
+
+func testSuiteVariableAttribute() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"key": schema.StringAttribute{
+				Required:            true,
+				Description:         "The variable's key",
+				MarkdownDescription: "The variable's key",
+			},
+			"type": schema.StringAttribute{
+				Required:            true,
+				Description:         "The variable's type",
+				MarkdownDescription: "The variable's type\n\n* `STRING` - String\n* `NUMBER` - Number\n* `JSON` - Json\n* `CHAT_HISTORY` - Chat History\n* `SEARCH_RESULTS` - Search Results\n* `ERROR` - Error\n* `ARRAY` - Array\n* `FUNCTION_CALL` - Function Call\n* `IMAGE` - Image\n* `NULL` - Null",
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						string(vellum.VellumVariableTypeString),
+						string(vellum.VellumVariableTypeNumber),
+						string(vellum.VellumVariableTypeJson),
+						string(vellum.VellumVariableTypeChatHistory),
+						string(vellum.VellumVariableTypeSearchResults),
+						string(vellum.VellumVariableTypeError),
+						string(vellum.VellumVariableTypeArray),
+						string(vellum.VellumVariableTypeFunctionCall),
+						string(vellum.VellumVariableTypeImage),
+						string(vellum.VellumVariableTypeNull),
+					),
+				},
+			},
+			"required": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "Whether every Test Case must provide a value for the variable",
+				MarkdownDescription: "Whether every Test Case must provide a value for the variable",
+			},
+		},
+	}
+}
+
+func (r *TestSuiteResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*vellumclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// ModifyPlan hashes every row of test_cases_file, so changing a row shows up
+// as a change to test_case_hashes.
+func (r *TestSuiteResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the resource is being destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var testSuitePlan *TfTestSuiteResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &testSuitePlan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if testSuitePlan.TestCasesFile.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("test_case_hashes"), types.MapNull(types.StringType))...)
+		return
+	}
+	if testSuitePlan.TestCasesFile.IsUnknown() || !variablesKnown(testSuitePlan.InputVariables) || !variablesKnown(testSuitePlan.EvaluationVariables) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("test_case_hashes"), types.MapUnknown(types.StringType))...)
+		return
+	}
+
+	testCases, err := readTestCasesFile(testSuitePlan.TestCasesFile.ValueString(), newTestCaseVariables(testSuitePlan))
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("test_cases_file"), "Invalid Test Cases File", err.Error())
+		return
+	}
+
+	hashes, err := testCaseHashes(testCases)
+	if err != nil {
+		resp.Diagnostics.AddError("failed to hash test cases", err.Error())
+		return
+	}
+
+	hashesValue, diags := types.MapValueFrom(ctx, types.StringType, hashes)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("test_case_hashes"), hashesValue)...)
+}
+
+func variablesKnown(variables []TfTestSuiteVariable) bool {
+	for _, variable := range variables {
+		if variable.Key.IsUnknown() || variable.Type.IsUnknown() {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *TestSuiteResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var testSuitePlan *TfTestSuiteResourceModel
+
+	diags := req.Plan.Get(ctx, &testSuitePlan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.WithResource(ctx, "vellum_test_suite "+testSuitePlan.Name.ValueString())
+
+	ctx, deadline, diags := timeout.Start(ctx, testSuitePlan.Timeouts, timeout.Create, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	testSuiteRequest, d := NewVellumTestSuiteCreateRequest(ctx, testSuitePlan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	testSuite, err := r.client.TestSuites.Create(idempotency.Context(ctx, "vellum_test_suite", testSuiteRequest), testSuiteRequest)
+	if idempotency.IsConflict(err) {
+		testSuite, err = r.adopt(ctx, testSuiteRequest, err)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create test suite, got error: %s", err))
+		return
+	}
+
+	if err := folder.MoveEntity(ctx, r.client, testSuite.Id, testSuitePlan.FolderId, types.StringNull(), folder.TestSuiteRootFolderId); err != nil {
+		// The Test Suite exists, so keep it in state outside of any Folder.
+		testSuitePlan.FolderId = types.StringNull()
+		testSuiteModel, _ := NewTfTestSuiteModel(ctx, testSuitePlan, testSuite, map[string]string{})
+		resp.Diagnostics.Append(resp.State.Set(ctx, &testSuiteModel)...)
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to move test suite into folder, got error: %s", err))
+		return
+	}
+
+	testCaseHashes, diagnostic := r.syncTestCases(ctx, testSuitePlan, testSuite.Id, nil)
+	if diagnostic.HasError() {
+		// The Test Suite exists, so keep it in state to be retried on the next apply.
+		testSuiteModel, _ := NewTfTestSuiteModel(ctx, testSuitePlan, testSuite, map[string]string{})
+		resp.Diagnostics.Append(resp.State.Set(ctx, &testSuiteModel)...)
+	}
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	testSuiteModel, diagnostic := NewTfTestSuiteModel(ctx, testSuitePlan, testSuite, testCaseHashes)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &testSuiteModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// adopt returns the Test Suite already holding the planned name, such as one
+// whose create succeeded without reaching the state, when it matches the
+// plan. Otherwise it returns why it can't be adopted, or createErr when it
+// can't be looked up.
+func (r *TestSuiteResource) adopt(ctx context.Context, request *vellum.TestSuiteCreateRequest, createErr error) (*vellum.TestSuiteRead, error) {
+	testSuite, err := r.client.TestSuites.Retrieve(ctx, request.Name)
+	if err != nil {
+		return nil, createErr
+	}
+
+	var mismatches idempotency.Mismatches
+	mismatches.Compare("label", request.Label, &testSuite.Label)
+	plannedInputVariables, existingInputVariables := requestVariablesString(request.InputVariables), variablesString(testSuite.InputVariables)
+	mismatches.Compare("input_variables", &plannedInputVariables, &existingInputVariables)
+	plannedEvaluationVariables, existingEvaluationVariables := requestVariablesString(request.EvaluationVariables), variablesString(testSuite.EvaluationVariables)
+	mismatches.Compare("evaluation_variables", &plannedEvaluationVariables, &existingEvaluationVariables)
+	if err := mismatches.Err("vellum_test_suite", request.Name, testSuite.Id); err != nil {
+		return nil, err
+	}
+
+	tflog.Info(ctx, "adopted existing test suite", map[string]interface{}{
+		"id":   testSuite.Id,
+		"name": testSuite.Name,
+	})
+	return testSuite, nil
+}
+
+// requestVariablesString lists the key and type of each variable, in order.
+func requestVariablesString(variables []*vellum.TestSuiteVariableRequest) string {
+	keys := make([]string, 0, len(variables))
+	for _, variable := range variables {
+		keys = append(keys, fmt.Sprintf("%s:%s", variable.Key, variable.Type))
+	}
+	return strings.Join(keys, ", ")
+}
+
+// variablesString lists the key and type of each variable, in order.
+func variablesString(variables []*vellum.VellumVariable) string {
+	keys := make([]string, 0, len(variables))
+	for _, variable := range variables {
+		keys = append(keys, fmt.Sprintf("%s:%s", variable.Key, variable.Type))
+	}
+	return strings.Join(keys, ", ")
+}
+
+func (r *TestSuiteResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var testSuiteState TfTestSuiteResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &testSuiteState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, deadline, diags := timeout.Start(ctx, testSuiteState.Timeouts, timeout.Read, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	testSuite, err := r.client.TestSuites.Retrieve(ctx, testSuiteState.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read test suite, got error: %s", err))
+		return
+	}
+
+	var testCaseHashes map[string]string
+	if !testSuiteState.TestCaseHashes.IsNull() {
+		managed := map[string]string{}
+		resp.Diagnostics.Append(testSuiteState.TestCaseHashes.ElementsAs(ctx, &managed, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		remote, err := listRemoteTestCases(ctx, r.client, testSuite.Id)
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list test cases, got error: %s", err))
+			return
+		}
+		testCaseHashes = refreshTestCaseHashes(managed, remote)
+	}
+
+	testSuiteModel, diagnostic := NewTfTestSuiteModel(ctx, &testSuiteState, testSuite, testCaseHashes)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &testSuiteModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *TestSuiteResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var testSuitePlan *TfTestSuiteResourceModel
+	var testSuiteState *TfTestSuiteResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &testSuitePlan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &testSuiteState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.WithResource(ctx, "vellum_test_suite "+testSuitePlan.Name.ValueString())
+
+	ctx, deadline, diags := timeout.Start(ctx, testSuitePlan.Timeouts, timeout.Update, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	testSuiteRequest, d := NewVellumTestSuiteUpdateRequest(ctx, testSuitePlan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	testSuite, err := r.client.TestSuites.PartialUpdate(ctx, testSuiteState.Id.ValueString(), testSuiteRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("error during test suite update", err.Error())
+		return
+	}
+
+	if err := folder.MoveEntity(ctx, r.client, testSuite.Id, testSuitePlan.FolderId, testSuiteState.FolderId, folder.TestSuiteRootFolderId); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to move test suite into folder, got error: %s", err))
+		return
+	}
+
+	managed := map[string]string{}
+	if !testSuiteState.TestCaseHashes.IsNull() {
+		resp.Diagnostics.Append(testSuiteState.TestCaseHashes.ElementsAs(ctx, &managed, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	testCaseHashes, diagnostic := r.syncTestCases(ctx, testSuitePlan, testSuite.Id, managed)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	testSuiteModel, diagnostic := NewTfTestSuiteModel(ctx, testSuitePlan, testSuite, testCaseHashes)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &testSuiteModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *TestSuiteResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var testSuiteState *TfTestSuiteResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &testSuiteState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.WithResource(ctx, "vellum_test_suite "+testSuiteState.Name.ValueString())
+
+	ctx, deadline, diags := timeout.Start(ctx, testSuiteState.Timeouts, timeout.Delete, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	err := r.client.TestSuites.Destroy(
+		ctx,
+		testSuiteState.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("error when destroying the test suite resource", err.Error())
+		return
+	}
+}
+
+func (r *TestSuiteResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// syncTestCases brings the Test Cases managed by test_cases_file in line
+// with the file, returning the hashes of the Test Cases it now manages. With
+// no file, every previously managed Test Case is deleted and nil is returned.
+func (r *TestSuiteResource) syncTestCases(ctx context.Context, testSuitePlan *TfTestSuiteResourceModel, testSuiteId string, managed map[string]string) (map[string]string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if testSuitePlan.TestCasesFile.IsNull() && len(managed) == 0 {
+		return nil, diags
+	}
+
+	variables := newTestCaseVariables(testSuitePlan)
+	var testCases []*testCase
+	if !testSuitePlan.TestCasesFile.IsNull() {
+		var err error
+		testCases, err = readTestCasesFile(testSuitePlan.TestCasesFile.ValueString(), variables)
+		if err != nil {
+			diags.AddAttributeError(path.Root("test_cases_file"), "Invalid Test Cases File", err.Error())
+			return nil, diags
+		}
+	}
+
+	if err := syncTestCases(ctx, r.client, testSuiteId, variables, testCases, managed); err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to sync test cases, got error: %s", err))
+		return nil, diags
+	}
+
+	if testSuitePlan.TestCasesFile.IsNull() {
+		return nil, diags
+	}
+	testCaseHashes, err := testCaseHashes(testCases)
+	if err != nil {
+		diags.AddError("failed to hash test cases", err.Error())
+		return nil, diags
+	}
+	return testCaseHashes, diags
+}
@@ -0,0 +1,486 @@
+package test_suite
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	vellum "terraform-provider-vellum/internal/sdk"
+	vellumclient "terraform-provider-vellum/internal/sdk/client"
+)
+
+// testCasesBulkBatchSize caps the number of operations sent in a single bulk
+// Test Case request.
+const testCasesBulkBatchSize = 100
+
+// CSV columns holding a Test Case's values are named after the variable,
+// prefixed by the kind of variable they're for.
+const (
+	csvExternalIdColumn       = "external_id"
+	csvLabelColumn            = "label"
+	csvInputColumnPrefix      = "input."
+	csvEvaluationColumnPrefix = "evaluation."
+)
+
+// testCase is a single row of a test cases file, with every value already
+// converted to the type of its variable.
+type testCase struct {
+	ExternalId       string                 `json:"-"`
+	Label            *string                `json:"label,omitempty"`
+	InputValues      map[string]interface{} `json:"input_values"`
+	EvaluationValues map[string]interface{} `json:"evaluation_values"`
+}
+
+// hash returns the SHA-256 hash of the Test Case's label and values, which is
+// what's compared to tell whether a row changed.
+func (t *testCase) hash() (string, error) {
+	// encoding/json sorts map keys, which keeps the hash deterministic.
+	encoded, err := json.Marshal(t)
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.Sum256(encoded)
+	return hex.EncodeToString(hash[:]), nil
+}
+
+// testCaseVariables holds the type of every input and evaluation variable of
+// a Test Suite, keyed by variable key.
+type testCaseVariables struct {
+	inputs      map[string]vellum.VellumVariableType
+	evaluations map[string]vellum.VellumVariableType
+}
+
+// VariableTypes maps the key of every variable to its type.
+func VariableTypes(variables []*vellum.VellumVariable) map[string]vellum.VellumVariableType {
+	variableTypes := map[string]vellum.VellumVariableType{}
+	for _, variable := range variables {
+		variableTypes[variable.Key] = variable.Type
+	}
+	return variableTypes
+}
+
+// ParseTestCaseValue parses the string form of a Test Case value. `STRING`
+// values are taken as-is and values of every other type are parsed as JSON.
+func ParseTestCaseValue(variableType vellum.VellumVariableType, value string) (interface{}, error) {
+	if variableType == vellum.VellumVariableTypeString {
+		return value, nil
+	}
+	var parsed interface{}
+	if err := json.Unmarshal([]byte(value), &parsed); err != nil {
+		return nil, fmt.Errorf("%s value must be valid JSON: %w", variableType, err)
+	}
+	return checkTestCaseValue(variableType, parsed)
+}
+
+// FormatTestCaseValue is the inverse of ParseTestCaseValue.
+func FormatTestCaseValue(variableType vellum.VellumVariableType, value interface{}) (string, error) {
+	if s, ok := value.(string); ok && variableType == vellum.VellumVariableTypeString {
+		return s, nil
+	}
+	encoded, err := json.Marshal(value)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
+
+func checkTestCaseValue(variableType vellum.VellumVariableType, value interface{}) (interface{}, error) {
+	switch variableType {
+	case vellum.VellumVariableTypeString:
+		if _, ok := value.(string); !ok {
+			return nil, fmt.Errorf("%s value must be a string", variableType)
+		}
+	case vellum.VellumVariableTypeNumber:
+		if _, ok := value.(float64); !ok {
+			return nil, fmt.Errorf("%s value must be a number", variableType)
+		}
+	}
+	return value, nil
+}
+
+// NewVellumTestCaseVariableValues converts a Test Case's values, keyed by
+// variable key, into named values typed after the given variables.
+func NewVellumTestCaseVariableValues(variableTypes map[string]vellum.VellumVariableType, values map[string]interface{}) ([]*vellum.NamedTestCaseVariableValueRequest, error) {
+	names := make([]string, 0, len(values))
+	for name := range values {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	namedValues := []*vellum.NamedTestCaseVariableValueRequest{}
+	for _, name := range names {
+		variableType, ok := variableTypes[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown variable %q", name)
+		}
+		namedValues = append(namedValues, &vellum.NamedTestCaseVariableValueRequest{
+			Type:  variableType,
+			Name:  name,
+			Value: values[name],
+		})
+	}
+	return namedValues, nil
+}
+
+// readTestCasesFile reads every Test Case of a `.jsonl` or `.csv` file.
+func readTestCasesFile(filePath string, variables *testCaseVariables) ([]*testCase, error) {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var testCases []*testCase
+	switch strings.ToLower(filepath.Ext(filePath)) {
+	case ".jsonl", ".ndjson":
+		testCases, err = readJSONLTestCases(file, variables)
+	case ".csv":
+		testCases, err = readCSVTestCases(file, variables)
+	default:
+		return nil, fmt.Errorf("%s must be a .jsonl or .csv file", filePath)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filePath, err)
+	}
+
+	seen := map[string]struct{}{}
+	for _, t := range testCases {
+		if _, ok := seen[t.ExternalId]; ok {
+			return nil, fmt.Errorf("%s: duplicate external_id %q", filePath, t.ExternalId)
+		}
+		seen[t.ExternalId] = struct{}{}
+	}
+	return testCases, nil
+}
+
+// readJSONLTestCases reads one JSON object per line, such as
+//
+//	{"external_id": "refund", "label": "Refund", "input_values": {"question": "..."}, "evaluation_values": {"answer": "..."}}
+//
+// Blank lines are skipped.
+func readJSONLTestCases(reader io.Reader, variables *testCaseVariables) ([]*testCase, error) {
+	type jsonlTestCase struct {
+		ExternalId       string                     `json:"external_id"`
+		Label            *string                    `json:"label"`
+		InputValues      map[string]json.RawMessage `json:"input_values"`
+		EvaluationValues map[string]json.RawMessage `json:"evaluation_values"`
+	}
+
+	var testCases []*testCase
+	decoder := json.NewDecoder(reader)
+	for row := 1; ; row++ {
+		var line jsonlTestCase
+		if err := decoder.Decode(&line); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("test case %d: %w", row, err)
+		}
+
+		t, err := newTestCase(line.ExternalId, line.Label)
+		if err != nil {
+			return nil, fmt.Errorf("test case %d: %w", row, err)
+		}
+		for name, raw := range line.InputValues {
+			if t.InputValues[name], err = decodeTestCaseValue(variables.inputs, name, raw); err != nil {
+				return nil, fmt.Errorf("test case %q: input %w", t.ExternalId, err)
+			}
+		}
+		for name, raw := range line.EvaluationValues {
+			if t.EvaluationValues[name], err = decodeTestCaseValue(variables.evaluations, name, raw); err != nil {
+				return nil, fmt.Errorf("test case %q: evaluation %w", t.ExternalId, err)
+			}
+		}
+		testCases = append(testCases, t)
+	}
+	return testCases, nil
+}
+
+func decodeTestCaseValue(variableTypes map[string]vellum.VellumVariableType, name string, raw json.RawMessage) (interface{}, error) {
+	variableType, ok := variableTypes[name]
+	if !ok {
+		return nil, fmt.Errorf("variable %q is not defined", name)
+	}
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, fmt.Errorf("variable %q: %w", name, err)
+	}
+	value, err := checkTestCaseValue(variableType, value)
+	if err != nil {
+		return nil, fmt.Errorf("variable %q: %w", name, err)
+	}
+	return value, nil
+}
+
+// readCSVTestCases reads a header row naming the `external_id` and optional
+// `label` columns, plus an `input.<key>` or `evaluation.<key>` column per
+// variable, followed by one Test Case per row. Empty cells are left out of
+// the Test Case, and non-`STRING` cells are parsed as JSON.
+func readCSVTestCases(reader io.Reader, variables *testCaseVariables) ([]*testCase, error) {
+	csvReader := csv.NewReader(reader)
+	header, err := csvReader.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	externalIdColumn, labelColumn := -1, -1
+	for column, name := range header {
+		switch {
+		case name == csvExternalIdColumn:
+			externalIdColumn = column
+		case name == csvLabelColumn:
+			labelColumn = column
+		case strings.HasPrefix(name, csvInputColumnPrefix):
+			if _, ok := variables.inputs[strings.TrimPrefix(name, csvInputColumnPrefix)]; !ok {
+				return nil, fmt.Errorf("column %q: input variable is not defined", name)
+			}
+		case strings.HasPrefix(name, csvEvaluationColumnPrefix):
+			if _, ok := variables.evaluations[strings.TrimPrefix(name, csvEvaluationColumnPrefix)]; !ok {
+				return nil, fmt.Errorf("column %q: evaluation variable is not defined", name)
+			}
+		default:
+			return nil, fmt.Errorf("column %q must be %s, %s, or start with %s or %s", name, csvExternalIdColumn, csvLabelColumn, csvInputColumnPrefix, csvEvaluationColumnPrefix)
+		}
+	}
+	if externalIdColumn < 0 {
+		return nil, fmt.Errorf("missing %s column", csvExternalIdColumn)
+	}
+
+	var testCases []*testCase
+	for {
+		record, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return nil, err
+		}
+
+		var label *string
+		if labelColumn >= 0 && record[labelColumn] != "" {
+			label = &record[labelColumn]
+		}
+		t, err := newTestCase(record[externalIdColumn], label)
+		if err != nil {
+			line, _ := csvReader.FieldPos(externalIdColumn)
+			return nil, fmt.Errorf("line %d: %w", line, err)
+		}
+		for column, name := range header {
+			if record[column] == "" {
+				continue
+			}
+			values, variableTypes, key := t.InputValues, variables.inputs, strings.TrimPrefix(name, csvInputColumnPrefix)
+			if strings.HasPrefix(name, csvEvaluationColumnPrefix) {
+				values, variableTypes, key = t.EvaluationValues, variables.evaluations, strings.TrimPrefix(name, csvEvaluationColumnPrefix)
+			} else if !strings.HasPrefix(name, csvInputColumnPrefix) {
+				continue
+			}
+			if values[key], err = ParseTestCaseValue(variableTypes[key], record[column]); err != nil {
+				return nil, fmt.Errorf("test case %q: column %q: %w", t.ExternalId, name, err)
+			}
+		}
+		testCases = append(testCases, t)
+	}
+	return testCases, nil
+}
+
+func newTestCase(externalId string, label *string) (*testCase, error) {
+	if externalId == "" {
+		return nil, fmt.Errorf("missing %s", csvExternalIdColumn)
+	}
+	if label != nil && *label == "" {
+		label = nil
+	}
+	return &testCase{
+		ExternalId:       externalId,
+		Label:            label,
+		InputValues:      map[string]interface{}{},
+		EvaluationValues: map[string]interface{}{},
+	}, nil
+}
+
+// newTestCaseFromVellum converts a Test Case read back from Vellum, so its
+// hash can be compared to the one of its row.
+func newTestCaseFromVellum(vellumTestCase *vellum.TestSuiteTestCase) *testCase {
+	t := &testCase{
+		Label:            vellumTestCase.Label,
+		InputValues:      map[string]interface{}{},
+		EvaluationValues: map[string]interface{}{},
+	}
+	if vellumTestCase.ExternalId != nil {
+		t.ExternalId = *vellumTestCase.ExternalId
+	}
+	if t.Label != nil && *t.Label == "" {
+		t.Label = nil
+	}
+	for _, value := range vellumTestCase.InputValues {
+		if value.Value != nil {
+			t.InputValues[value.Name] = value.Value
+		}
+	}
+	for _, value := range vellumTestCase.EvaluationValues {
+		if value.Value != nil {
+			t.EvaluationValues[value.Name] = value.Value
+		}
+	}
+	return t
+}
+
+// testCaseHashes maps the external ID of every Test Case to its hash.
+func testCaseHashes(testCases []*testCase) (map[string]string, error) {
+	hashes := map[string]string{}
+	for _, t := range testCases {
+		hash, err := t.hash()
+		if err != nil {
+			return nil, err
+		}
+		hashes[t.ExternalId] = hash
+	}
+	return hashes, nil
+}
+
+// remoteTestCase is a Test Case with an external ID, as it currently exists
+// in Vellum.
+type remoteTestCase struct {
+	id   string
+	hash string
+}
+
+// listRemoteTestCases returns every Test Case of the Test Suite that has an
+// external ID, keyed by it.
+func listRemoteTestCases(ctx context.Context, client *vellumclient.Client, testSuiteId string) (map[string]*remoteTestCase, error) {
+	vellumTestCases, err := client.TestSuites.ListAllTestSuiteTestCases(ctx, testSuiteId, &vellum.TestSuitesListTestSuiteTestCasesRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	remote := map[string]*remoteTestCase{}
+	for _, vellumTestCase := range vellumTestCases {
+		if vellumTestCase.Id == nil || vellumTestCase.ExternalId == nil || *vellumTestCase.ExternalId == "" {
+			continue
+		}
+		hash, err := newTestCaseFromVellum(vellumTestCase).hash()
+		if err != nil {
+			return nil, err
+		}
+		remote[*vellumTestCase.ExternalId] = &remoteTestCase{id: *vellumTestCase.Id, hash: hash}
+	}
+	return remote, nil
+}
+
+// refreshTestCaseHashes returns the current hash of every managed Test Case
+// that still exists in Vellum.
+func refreshTestCaseHashes(managed map[string]string, remote map[string]*remoteTestCase) map[string]string {
+	hashes := map[string]string{}
+	for externalId := range managed {
+		if r, ok := remote[externalId]; ok {
+			hashes[externalId] = r.hash
+		}
+	}
+	return hashes
+}
+
+// syncTestCases upserts every row whose Test Case is missing or differs in
+// Vellum, and deletes the previously managed Test Cases whose rows were
+// removed. Test Cases that were never managed by the file are left alone.
+func syncTestCases(ctx context.Context, client *vellumclient.Client, testSuiteId string, variables *testCaseVariables, testCases []*testCase, managed map[string]string) error {
+	remote, err := listRemoteTestCases(ctx, client, testSuiteId)
+	if err != nil {
+		return err
+	}
+
+	var operations []*vellum.TestSuiteTestCaseBulkOperationRequest
+	local := map[string]struct{}{}
+	for _, t := range testCases {
+		local[t.ExternalId] = struct{}{}
+		hash, err := t.hash()
+		if err != nil {
+			return err
+		}
+		if r, ok := remote[t.ExternalId]; ok && r.hash == hash {
+			continue
+		}
+		request, err := newVellumTestCaseRequest(t, variables)
+		if err != nil {
+			return err
+		}
+		operations = append(operations, &vellum.TestSuiteTestCaseBulkOperationRequest{
+			Id:   "upsert:" + t.ExternalId,
+			Type: vellum.TestSuiteTestCaseBulkOperationTypeUpsert,
+			Data: request,
+		})
+	}
+	upserts := len(operations)
+
+	var removed []string
+	for externalId := range managed {
+		if _, ok := local[externalId]; !ok {
+			removed = append(removed, externalId)
+		}
+	}
+	sort.Strings(removed)
+	for _, externalId := range removed {
+		r, ok := remote[externalId]
+		if !ok {
+			continue
+		}
+		operations = append(operations, &vellum.TestSuiteTestCaseBulkOperationRequest{
+			Id:   "delete:" + externalId,
+			Type: vellum.TestSuiteTestCaseBulkOperationTypeDelete,
+			Data: &vellum.TestSuiteTestCaseDeleteBulkOperationDataRequest{Id: r.id},
+		})
+	}
+
+	tflog.Info(ctx, "syncing test cases", map[string]interface{}{
+		"test_suite_id": testSuiteId,
+		"upserts":       upserts,
+		"deletes":       len(operations) - upserts,
+	})
+
+	var errs []error
+	for start := 0; start < len(operations); start += testCasesBulkBatchSize {
+		end := start + testCasesBulkBatchSize
+		if end > len(operations) {
+			end = len(operations)
+		}
+		results, err := client.TestSuites.TestSuiteTestCasesBulk(ctx, testSuiteId, operations[start:end])
+		if err != nil {
+			return err
+		}
+		for _, result := range results {
+			if result.Type == vellum.TestSuiteTestCaseBulkResultTypeRejected {
+				errs = append(errs, fmt.Errorf("%s was rejected: %v", result.Id, result.Data["detail"]))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func newVellumTestCaseRequest(t *testCase, variables *testCaseVariables) (*vellum.TestSuiteTestCaseRequest, error) {
+	inputValues, err := NewVellumTestCaseVariableValues(variables.inputs, t.InputValues)
+	if err != nil {
+		return nil, fmt.Errorf("test case %q: input %w", t.ExternalId, err)
+	}
+	evaluationValues, err := NewVellumTestCaseVariableValues(variables.evaluations, t.EvaluationValues)
+	if err != nil {
+		return nil, fmt.Errorf("test case %q: evaluation %w", t.ExternalId, err)
+	}
+	externalId := t.ExternalId
+	return &vellum.TestSuiteTestCaseRequest{
+		ExternalId:       &externalId,
+		Label:            t.Label,
+		InputValues:      inputValues,
+		EvaluationValues: evaluationValues,
+	}, nil
+}
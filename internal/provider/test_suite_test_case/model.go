@@ -0,0 +1,134 @@
+package test_suite_test_case
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-vellum/internal/provider/test_suite"
+	vellum "terraform-provider-vellum/internal/sdk"
+)
+
+// NewVellumTestSuiteTestCaseRequest types the model's values after the
+// variables of its Test Suite.
+func NewVellumTestSuiteTestCaseRequest(ctx context.Context, testCaseModel *TfTestSuiteTestCaseResourceModel, testSuite *vellum.TestSuiteRead) (*vellum.TestSuiteTestCaseRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	inputValues, err := newVellumTestCaseVariableValues(ctx, test_suite.VariableTypes(testSuite.InputVariables), testCaseModel.InputValues)
+	if err != nil {
+		diags.AddError("Invalid Test Case", fmt.Sprintf("input_values: %s", err))
+		return nil, diags
+	}
+	evaluationValues, err := newVellumTestCaseVariableValues(ctx, test_suite.VariableTypes(testSuite.EvaluationVariables), testCaseModel.EvaluationValues)
+	if err != nil {
+		diags.AddError("Invalid Test Case", fmt.Sprintf("evaluation_values: %s", err))
+		return nil, diags
+	}
+
+	request := vellum.TestSuiteTestCaseRequest{
+		Id:               testCaseModel.Id.ValueStringPointer(),
+		ExternalId:       testCaseModel.ExternalId.ValueStringPointer(),
+		Label:            testCaseModel.Label.ValueStringPointer(),
+		InputValues:      inputValues,
+		EvaluationValues: evaluationValues,
+	}
+	if testCaseModel.Id.IsUnknown() {
+		request.Id = nil
+	}
+
+	return &request, diags
+}
+
+func newVellumTestCaseVariableValues(ctx context.Context, variableTypes map[string]vellum.VellumVariableType, values types.Map) ([]*vellum.NamedTestCaseVariableValueRequest, error) {
+	stringValues := map[string]string{}
+	if diags := values.ElementsAs(ctx, &stringValues, false); diags.HasError() {
+		return nil, fmt.Errorf("unable to read values")
+	}
+
+	parsedValues := map[string]interface{}{}
+	for name, value := range stringValues {
+		variableType, ok := variableTypes[name]
+		if !ok {
+			return nil, fmt.Errorf("unknown variable %q", name)
+		}
+		parsed, err := test_suite.ParseTestCaseValue(variableType, value)
+		if err != nil {
+			return nil, fmt.Errorf("variable %q: %w", name, err)
+		}
+		parsedValues[name] = parsed
+	}
+	return test_suite.NewVellumTestCaseVariableValues(variableTypes, parsedValues)
+}
+
+// NewTfTestSuiteTestCaseModel keeps the model's own string for every JSON
+// value that's equal to the one read back, so formatting never shows up as a
+// diff.
+func NewTfTestSuiteTestCaseModel(ctx context.Context, model *TfTestSuiteTestCaseResourceModel, testCase *vellum.TestSuiteTestCase) (*TfTestSuiteTestCaseResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	testCaseModel := &TfTestSuiteTestCaseResourceModel{
+		Id:          types.StringPointerValue(testCase.Id),
+		TestSuiteId: model.TestSuiteId,
+		ExternalId:  types.StringPointerValue(testCase.ExternalId),
+		Label:       types.StringPointerValue(testCase.Label),
+		Timeouts:    model.Timeouts,
+	}
+	if testCaseModel.ExternalId.ValueString() == "" && model.ExternalId.IsNull() {
+		testCaseModel.ExternalId = types.StringNull()
+	}
+	if testCaseModel.Label.ValueString() == "" && model.Label.IsNull() {
+		testCaseModel.Label = types.StringNull()
+	}
+
+	var d diag.Diagnostics
+	testCaseModel.InputValues, d = newTfTestCaseVariableValues(ctx, model.InputValues, testCase.InputValues)
+	diags.Append(d...)
+	testCaseModel.EvaluationValues, d = newTfTestCaseVariableValues(ctx, model.EvaluationValues, testCase.EvaluationValues)
+	diags.Append(d...)
+	if len(testCaseModel.EvaluationValues.Elements()) == 0 && model.EvaluationValues.IsNull() {
+		testCaseModel.EvaluationValues = types.MapNull(types.StringType)
+	}
+
+	return testCaseModel, diags
+}
+
+func newTfTestCaseVariableValues(ctx context.Context, prior types.Map, values []*vellum.NamedTestCaseVariableValue) (types.Map, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	priorValues := map[string]string{}
+	if !prior.IsNull() && !prior.IsUnknown() {
+		diags.Append(prior.ElementsAs(ctx, &priorValues, false)...)
+	}
+
+	stringValues := map[string]string{}
+	for _, value := range values {
+		if value.Value == nil {
+			continue
+		}
+		formatted, err := test_suite.FormatTestCaseValue(value.Type, value.Value)
+		if err != nil {
+			diags.AddError("failed to format test case value", fmt.Sprintf("variable %q: %s", value.Name, err))
+			continue
+		}
+		if priorValue, ok := priorValues[value.Name]; ok && value.Type != vellum.VellumVariableTypeString && jsonEqual(priorValue, formatted) {
+			formatted = priorValue
+		}
+		stringValues[value.Name] = formatted
+	}
+
+	mapValue, d := types.MapValueFrom(ctx, types.StringType, stringValues)
+	diags.Append(d...)
+	return mapValue, diags
+}
+
+func jsonEqual(a string, b string) bool {
+	var decodedA, decodedB interface{}
+	if json.Unmarshal([]byte(a), &decodedA) != nil || json.Unmarshal([]byte(b), &decodedB) != nil {
+		return false
+	}
+	return reflect.DeepEqual(decodedA, decodedB)
+}
@@ -0,0 +1,292 @@
+package test_suite_test_case
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-vellum/internal/provider/timeout"
+	vellum "terraform-provider-vellum/internal/sdk"
+	vellumclient "terraform-provider-vellum/internal/sdk/client"
+	"terraform-provider-vellum/internal/sdk/core"
+)
+
+var _ resource.ResourceWithConfigure = &TestSuiteTestCaseResource{}
+var _ resource.ResourceWithImportState = &TestSuiteTestCaseResource{}
+
+type TestSuiteTestCaseResource struct {
+	client *vellumclient.Client
+}
+
+func Resource() resource.Resource {
+	return &TestSuiteTestCaseResource{}
+}
+
+type TfTestSuiteTestCaseResourceModel struct {
+	Id               types.String   `tfsdk:"id"`
+	TestSuiteId      types.String   `tfsdk:"test_suite_id"`
+	ExternalId       types.String   `tfsdk:"external_id"`
+	Label            types.String   `tfsdk:"label"`
+	InputValues      types.Map      `tfsdk:"input_values"`
+	EvaluationValues types.Map      `tfsdk:"evaluation_values"`
+	Timeouts         timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *TestSuiteTestCaseResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_test_suite_test_case"
+}
+
+func (r *TestSuiteTestCaseResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Test Suite Test Case resource. Values are keyed by variable and typed after the variables of the Test Suite: " +
+			"`STRING` values are taken as-is, and values of every other type are JSON, such as `jsonencode(...)` or `\"42\"` for a `NUMBER`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The Test Case's ID",
+				MarkdownDescription: "The Test Case's ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"test_suite_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Test Suite the Test Case belongs to",
+				MarkdownDescription: "The ID of the Test Suite the Test Case belongs to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"external_id": schema.StringAttribute{
+				Optional:            true,
+				Description:         "An ID external to Vellum that uniquely identifies the Test Case within its Test Suite",
+				MarkdownDescription: "An ID external to Vellum that uniquely identifies the Test Case within its Test Suite",
+			},
+			"label": schema.StringAttribute{
+				Optional:            true,
+				Description:         "A human-readable label used to convey the intention of this Test Case",
+				MarkdownDescription: "A human-readable label used to convey the intention of this Test Case",
+			},
+			"input_values": schema.MapAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				Description:         "The Test Case's value for each of the Test Suite's input variables, keyed by variable",
+				MarkdownDescription: "The Test Case's value for each of the Test Suite's input variables, keyed by variable",
+			},
+			"evaluation_values": schema.MapAttribute{
+				Optional:            true,
+				ElementType:         types.StringType,
+				Description:         "The Test Case's expected value for each of the Test Suite's evaluation variables, keyed by variable",
+				MarkdownDescription: "The Test Case's expected value for each of the Test Suite's evaluation variables, keyed by variable",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeout.Block(ctx),
+		},
+	}
+}
+
+func (r *TestSuiteTestCaseResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*vellumclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TestSuiteTestCaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var testCasePlan *TfTestSuiteTestCaseResourceModel
+
+	diags := req.Plan.Get(ctx, &testCasePlan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.WithResource(ctx, "vellum_test_suite_test_case "+testCasePlan.Label.ValueString())
+
+	ctx, deadline, diags := timeout.Start(ctx, testCasePlan.Timeouts, timeout.Create, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	testCaseModel, diagnostic := r.upsert(ctx, testCasePlan)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &testCaseModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *TestSuiteTestCaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var testCaseState TfTestSuiteTestCaseResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &testCaseState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, deadline, diags := timeout.Start(ctx, testCaseState.Timeouts, timeout.Read, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	testCases, err := r.client.TestSuites.ListAllTestSuiteTestCases(ctx, testCaseState.TestSuiteId.ValueString(), &vellum.TestSuitesListTestSuiteTestCasesRequest{})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read test suite test case, got error: %s", err))
+		return
+	}
+
+	var testCase *vellum.TestSuiteTestCase
+	for _, t := range testCases {
+		if t.Id != nil && *t.Id == testCaseState.Id.ValueString() {
+			testCase = t
+			break
+		}
+	}
+	if testCase == nil {
+		// The Test Case was deleted outside of Terraform.
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	testCaseModel, diagnostic := NewTfTestSuiteTestCaseModel(ctx, &testCaseState, testCase)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &testCaseModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *TestSuiteTestCaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var testCasePlan *TfTestSuiteTestCaseResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &testCasePlan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.WithResource(ctx, "vellum_test_suite_test_case "+testCasePlan.Label.ValueString())
+
+	ctx, deadline, diags := timeout.Start(ctx, testCasePlan.Timeouts, timeout.Update, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	testCaseModel, diagnostic := r.upsert(ctx, testCasePlan)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &testCaseModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *TestSuiteTestCaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var testCaseState *TfTestSuiteTestCaseResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &testCaseState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.WithResource(ctx, "vellum_test_suite_test_case "+testCaseState.Label.ValueString())
+
+	ctx, deadline, diags := timeout.Start(ctx, testCaseState.Timeouts, timeout.Delete, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	err := r.client.TestSuites.DeleteTestSuiteTestCase(
+		ctx,
+		testCaseState.TestSuiteId.ValueString(),
+		testCaseState.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("error when destroying the test suite test case resource", err.Error())
+		return
+	}
+}
+
+func (r *TestSuiteTestCaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	testSuiteId, id, ok := strings.Cut(req.ID, "/")
+	if !ok || testSuiteId == "" || id == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier of the form <test_suite_id>/<id>, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("test_suite_id"), testSuiteId)...)
+}
+
+// upsert creates the Test Case, or fully replaces it once it has an ID, with
+// its values typed after the variables of its Test Suite.
+func (r *TestSuiteTestCaseResource) upsert(ctx context.Context, testCasePlan *TfTestSuiteTestCaseResourceModel) (*TfTestSuiteTestCaseResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	testSuite, err := r.client.TestSuites.Retrieve(ctx, testCasePlan.TestSuiteId.ValueString())
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read test suite, got error: %s", err))
+		return nil, diags
+	}
+
+	testCaseRequest, d := NewVellumTestSuiteTestCaseRequest(ctx, testCasePlan, testSuite)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	testCase, err := r.client.TestSuites.UpsertTestSuiteTestCase(ctx, testSuite.Id, testCaseRequest)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to upsert test suite test case, got error: %s", err))
+		return nil, diags
+	}
+
+	testCaseModel, d := NewTfTestSuiteTestCaseModel(ctx, testCasePlan, testCase)
+	diags.Append(d...)
+	return testCaseModel, diags
+}
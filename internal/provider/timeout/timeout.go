@@ -0,0 +1,90 @@
+package timeout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// DefaultTimeout is how long an operation may run when its timeout isn't
+// configured.
+const DefaultTimeout = 20 * time.Minute
+
+// Operation is a resource operation with a configurable timeout.
+type Operation string
+
+const (
+	Create Operation = "create"
+	Read   Operation = "read"
+	Update Operation = "update"
+	Delete Operation = "delete"
+)
+
+// Block returns the `timeouts` block, with a timeout for each operation.
+func Block(ctx context.Context) schema.Block {
+	return timeouts.Block(ctx, timeouts.Opts{
+		Create: true,
+		Read:   true,
+		Update: true,
+		Delete: true,
+	})
+}
+
+// Deadline caps how long an operation runs, so a hung Vellum call can't
+// stall a whole apply.
+type Deadline struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	operation Operation
+	timeout   time.Duration
+}
+
+// Start returns a context that's done once the operation's timeout elapses,
+// defaulting to the given default. End the Deadline once the operation is
+// done. When the timeout can't be read, the given context is returned as is,
+// with no Deadline.
+func Start(ctx context.Context, value timeouts.Value, operation Operation, defaultTimeout time.Duration) (context.Context, *Deadline, diag.Diagnostics) {
+	var timeout time.Duration
+	var diags diag.Diagnostics
+	switch operation {
+	case Create:
+		timeout, diags = value.Create(ctx, defaultTimeout)
+	case Read:
+		timeout, diags = value.Read(ctx, defaultTimeout)
+	case Update:
+		timeout, diags = value.Update(ctx, defaultTimeout)
+	case Delete:
+		timeout, diags = value.Delete(ctx, defaultTimeout)
+	}
+	if diags.HasError() {
+		return ctx, nil, diags
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	return ctx, &Deadline{
+		ctx:       ctx,
+		cancel:    cancel,
+		operation: operation,
+		timeout:   timeout,
+	}, diags
+}
+
+// End releases the Deadline. When the operation failed after running out of
+// time, it adds an error that names the operation.
+func (d *Deadline) End(diags *diag.Diagnostics) {
+	defer d.cancel()
+
+	if diags.HasError() && errors.Is(d.ctx.Err(), context.DeadlineExceeded) {
+		diags.AddError(
+			fmt.Sprintf("%s Timed Out", strings.ToUpper(string(d.operation[:1]))+string(d.operation[1:])),
+			fmt.Sprintf("The %s operation didn't complete within its %s timeout. "+
+				"If it needs longer, raise `%s` in the resource's `timeouts` block.", d.operation, d.timeout, d.operation),
+		)
+	}
+}
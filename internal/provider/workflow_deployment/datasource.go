@@ -0,0 +1,201 @@
+package workflow_deployment
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	vellumclient "terraform-provider-vellum/internal/sdk/client"
+)
+
+func DataSource() datasource.DataSource {
+	return &WorkflowDeploymentDataSource{}
+}
+
+type WorkflowDeploymentDataSource struct {
+	client *vellumclient.Client
+}
+
+var _ datasource.DataSource = &WorkflowDeploymentDataSource{}
+var _ datasource.DataSourceWithConfigure = &WorkflowDeploymentDataSource{}
+
+type TfWorkflowDeploymentDataSourceModel struct {
+	Created         types.String         `tfsdk:"created"`
+	Description     types.String         `tfsdk:"description"`
+	Environment     types.String         `tfsdk:"environment"`
+	Id              types.String         `tfsdk:"id"`
+	Label           types.String         `tfsdk:"label"`
+	Name            types.String         `tfsdk:"name"`
+	Status          types.String         `tfsdk:"status"`
+	LastDeployedOn  types.String         `tfsdk:"last_deployed_on"`
+	LatestReleaseId types.String         `tfsdk:"latest_release_id"`
+	InputVariables  []TfWorkflowVariable `tfsdk:"input_variables"`
+	OutputVariables []TfWorkflowVariable `tfsdk:"output_variables"`
+}
+
+type TfWorkflowVariable struct {
+	Id       types.String `tfsdk:"id"`
+	Key      types.String `tfsdk:"key"`
+	Type     types.String `tfsdk:"type"`
+	Required types.Bool   `tfsdk:"required"`
+}
+
+func (d *WorkflowDeploymentDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_deployment"
+}
+
+func (d *WorkflowDeploymentDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Workflow Deployment data source",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "The Workflow Deployment's ID. Exactly one of `id` or `name` must be set.",
+				MarkdownDescription: "The Workflow Deployment's ID. Exactly one of `id` or `name` must be set.",
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(
+						path.MatchRoot("id"),
+						path.MatchRoot("name"),
+					),
+				},
+			},
+			"name": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "A name that uniquely identifies this Workflow Deployment within its workspace",
+				MarkdownDescription: "A name that uniquely identifies this Workflow Deployment within its workspace",
+			},
+			"created": schema.StringAttribute{
+				Computed: true,
+			},
+			"description": schema.StringAttribute{
+				Computed:            true,
+				Description:         "A human-readable description of the Workflow Deployment",
+				MarkdownDescription: "A human-readable description of the Workflow Deployment",
+			},
+			"environment": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The environment this Workflow Deployment is used in\n\n* `DEVELOPMENT` - Development\n* `STAGING` - Staging\n* `PRODUCTION` - Production",
+				MarkdownDescription: "The environment this Workflow Deployment is used in\n\n* `DEVELOPMENT` - Development\n* `STAGING` - Staging\n* `PRODUCTION` - Production",
+			},
+			"label": schema.StringAttribute{
+				Computed:            true,
+				Description:         "A human-readable label for the Workflow Deployment",
+				MarkdownDescription: "A human-readable label for the Workflow Deployment",
+			},
+			"status": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The current status of the Workflow Deployment\n\n* `ACTIVE` - Active\n* `ARCHIVED` - Archived",
+				MarkdownDescription: "The current status of the Workflow Deployment\n\n* `ACTIVE` - Active\n* `ARCHIVED` - Archived",
+			},
+			"last_deployed_on": schema.StringAttribute{
+				Computed:            true,
+				Description:         "When the latest release was deployed",
+				MarkdownDescription: "When the latest release was deployed",
+			},
+			"latest_release_id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The ID of the release the `LATEST` Release Tag points at",
+				MarkdownDescription: "The ID of the release the `LATEST` Release Tag points at",
+			},
+			"input_variables": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "The inputs the Workflow Deployment expects values for when it's executed",
+				MarkdownDescription: "The inputs the Workflow Deployment expects values for when it's executed",
+				NestedObject:        workflowVariableAttribute(),
+			},
+			"output_variables": schema.ListNestedAttribute{
+				Computed:            true,
+				Description:         "The outputs the Workflow Deployment produces values for when it's executed",
+				MarkdownDescription: "The outputs the Workflow Deployment produces values for when it's executed",
+				NestedObject:        workflowVariableAttribute(),
+			},
+		},
+	}
+}
+
+func workflowVariableAttribute() schema.NestedAttributeObject {
+	return schema.NestedAttributeObject{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The variable's ID",
+				MarkdownDescription: "The variable's ID",
+			},
+			"key": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The name the variable is referenced by",
+				MarkdownDescription: "The name the variable is referenced by",
+			},
+			"type": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The variable's type, e.g. `STRING`, `NUMBER`, `JSON` or `CHAT_HISTORY`",
+				MarkdownDescription: "The variable's type, e.g. `STRING`, `NUMBER`, `JSON` or `CHAT_HISTORY`",
+			},
+			"required": schema.BoolAttribute{
+				Computed:            true,
+				Description:         "Whether a value must be provided for the variable",
+				MarkdownDescription: "Whether a value must be provided for the variable",
+			},
+		},
+	}
+}
+
+func (d *WorkflowDeploymentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*vellumclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *WorkflowDeploymentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var workflowDeploymentModel *TfWorkflowDeploymentDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &workflowDeploymentModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	workflowDeploymentRetrieveParameter := workflowDeploymentModel.Name.ValueString()
+	if workflowDeploymentRetrieveParameter == "" {
+		workflowDeploymentRetrieveParameter = workflowDeploymentModel.Id.ValueString()
+	}
+
+	workflowDeployment, err := d.client.WorkflowDeployments.Retrieve(ctx, workflowDeploymentRetrieveParameter)
+	if err != nil {
+		resp.Diagnostics.AddError("error getting Workflow Deployment information", err.Error())
+		return
+	}
+
+	workflowDeploymentModel, diagnostic := NewTfWorkflowDeploymentDataSourceModel(ctx, workflowDeployment)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &workflowDeploymentModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
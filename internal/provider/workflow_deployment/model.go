@@ -0,0 +1,48 @@
+package workflow_deployment
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	vellum "terraform-provider-vellum/internal/sdk"
+)
+
+func NewTfWorkflowDeploymentDataSourceModel(ctx context.Context, workflowDeployment *vellum.WorkflowDeploymentRead) (*TfWorkflowDeploymentDataSourceModel, diag.Diagnostics) {
+	workflowDeploymentModel := &TfWorkflowDeploymentDataSourceModel{
+		Id:              types.StringValue(workflowDeployment.Id),
+		Name:            types.StringValue(workflowDeployment.Name),
+		Created:         types.StringValue(workflowDeployment.Created.String()),
+		Description:     types.StringPointerValue(workflowDeployment.Description),
+		Environment:     types.StringNull(),
+		Label:           types.StringValue(workflowDeployment.Label),
+		Status:          types.StringNull(),
+		LastDeployedOn:  types.StringValue(workflowDeployment.LastDeployedOn.String()),
+		LatestReleaseId: types.StringValue(workflowDeployment.LastDeployedHistoryItemId),
+		InputVariables:  newTfWorkflowVariables(workflowDeployment.InputVariables),
+		OutputVariables: newTfWorkflowVariables(workflowDeployment.OutputVariables),
+	}
+
+	if workflowDeployment.Environment != nil {
+		workflowDeploymentModel.Environment = types.StringValue(string(*workflowDeployment.Environment))
+	}
+	if workflowDeployment.Status != nil {
+		workflowDeploymentModel.Status = types.StringValue(string(*workflowDeployment.Status))
+	}
+
+	return workflowDeploymentModel, nil
+}
+
+func newTfWorkflowVariables(variables []*vellum.VellumVariable) []TfWorkflowVariable {
+	workflowVariables := []TfWorkflowVariable{}
+	for _, variable := range variables {
+		workflowVariables = append(workflowVariables, TfWorkflowVariable{
+			Id:       types.StringValue(variable.Id),
+			Key:      types.StringValue(variable.Key),
+			Type:     types.StringValue(string(variable.Type)),
+			Required: types.BoolPointerValue(variable.Required),
+		})
+	}
+	return workflowVariables
+}
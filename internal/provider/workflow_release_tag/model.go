@@ -0,0 +1,29 @@
+package workflow_release_tag
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	vellum "terraform-provider-vellum/internal/sdk"
+)
+
+func NewTfWorkflowReleaseTagModel(ctx context.Context, model *TfWorkflowReleaseTagResourceModel, releaseTag *vellum.WorkflowReleaseTagRead) (*TfWorkflowReleaseTagResourceModel, diag.Diagnostics) {
+	releaseTagModel := &TfWorkflowReleaseTagResourceModel{
+		Id:                   types.StringValue(model.WorkflowDeploymentId.ValueString() + "/" + releaseTag.Name),
+		WorkflowDeploymentId: model.WorkflowDeploymentId,
+		Name:                 types.StringValue(releaseTag.Name),
+		ReleaseId:            types.StringNull(),
+		Source:               types.StringValue(string(releaseTag.Source)),
+		ReleasedAt:           types.StringNull(),
+		Timeouts:             model.Timeouts,
+	}
+
+	if releaseTag.HistoryItem != nil {
+		releaseTagModel.ReleaseId = types.StringValue(releaseTag.HistoryItem.Id)
+		releaseTagModel.ReleasedAt = types.StringValue(releaseTag.HistoryItem.Timestamp.String())
+	}
+
+	return releaseTagModel, nil
+}
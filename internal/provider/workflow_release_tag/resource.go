@@ -0,0 +1,280 @@
+package workflow_release_tag
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-vellum/internal/provider/timeout"
+	vellum "terraform-provider-vellum/internal/sdk"
+	vellumclient "terraform-provider-vellum/internal/sdk/client"
+	"terraform-provider-vellum/internal/sdk/core"
+)
+
+var _ resource.ResourceWithConfigure = &WorkflowReleaseTagResource{}
+var _ resource.ResourceWithImportState = &WorkflowReleaseTagResource{}
+
+type WorkflowReleaseTagResource struct {
+	client *vellumclient.Client
+}
+
+func Resource() resource.Resource {
+	return &WorkflowReleaseTagResource{}
+}
+
+type TfWorkflowReleaseTagResourceModel struct {
+	Id                   types.String   `tfsdk:"id"`
+	WorkflowDeploymentId types.String   `tfsdk:"workflow_deployment_id"`
+	Name                 types.String   `tfsdk:"name"`
+	ReleaseId            types.String   `tfsdk:"release_id"`
+	Source               types.String   `tfsdk:"source"`
+	ReleasedAt           types.String   `tfsdk:"released_at"`
+	Timeouts             timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *WorkflowReleaseTagResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workflow_release_tag"
+}
+
+func (r *WorkflowReleaseTagResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Workflow Release Tag resource. Pins a Release Tag of a Workflow Deployment to a specific release. " +
+			"Vellum has no way to delete a Release Tag, so destroying this resource leaves the tag pointing at its last release. " +
+			"Import it with `<workflow_deployment_id>/<name>`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The Release Tag's ID, in the form `<workflow_deployment_id>/<name>`",
+				MarkdownDescription: "The Release Tag's ID, in the form `<workflow_deployment_id>/<name>`",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"workflow_deployment_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Workflow Deployment the Release Tag belongs to",
+				MarkdownDescription: "The ID of the Workflow Deployment the Release Tag belongs to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "The name of the Release Tag, e.g. `production`",
+				MarkdownDescription: "The name of the Release Tag, e.g. `production`",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"release_id": schema.StringAttribute{
+				Required:            true,
+				Description:         "The ID of the Workflow Deployment release the Release Tag points at",
+				MarkdownDescription: "The ID of the Workflow Deployment release the Release Tag points at",
+			},
+			"source": schema.StringAttribute{
+				Computed:            true,
+				Description:         "How the Release Tag was originally created\n\n* `SYSTEM` - System\n* `USER` - User",
+				MarkdownDescription: "How the Release Tag was originally created\n\n* `SYSTEM` - System\n* `USER` - User",
+			},
+			"released_at": schema.StringAttribute{
+				Computed:            true,
+				Description:         "When the release the Release Tag points at was created",
+				MarkdownDescription: "When the release the Release Tag points at was created",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeout.Block(ctx),
+		},
+	}
+}
+
+func (r *WorkflowReleaseTagResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*vellumclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *WorkflowReleaseTagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var releaseTagPlan *TfWorkflowReleaseTagResourceModel
+
+	diags := req.Plan.Get(ctx, &releaseTagPlan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.WithResource(ctx, "vellum_workflow_release_tag "+releaseTagPlan.Name.ValueString())
+
+	ctx, deadline, diags := timeout.Start(ctx, releaseTagPlan.Timeouts, timeout.Create, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	releaseTag, err := r.client.WorkflowDeployments.UpdateWorkflowReleaseTag(ctx,
+		releaseTagPlan.WorkflowDeploymentId.ValueString(),
+		releaseTagPlan.Name.ValueString(),
+		&vellum.PatchedWorkflowReleaseTagUpdateRequest{
+			HistoryItemId: releaseTagPlan.ReleaseId.ValueStringPointer(),
+		})
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to tag workflow deployment release, got error: %s", err))
+		return
+	}
+
+	releaseTagModel, diagnostic := NewTfWorkflowReleaseTagModel(ctx, releaseTagPlan, releaseTag)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &releaseTagModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *WorkflowReleaseTagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var releaseTagState TfWorkflowReleaseTagResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &releaseTagState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, deadline, diags := timeout.Start(ctx, releaseTagState.Timeouts, timeout.Read, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	// A tag moved in the UI shows up as a change to release_id.
+	releaseTag, err := r.client.WorkflowDeployments.RetrieveWorkflowReleaseTag(ctx,
+		releaseTagState.WorkflowDeploymentId.ValueString(),
+		releaseTagState.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workflow release tag, got error: %s", err))
+		return
+	}
+
+	releaseTagModel, diagnostic := NewTfWorkflowReleaseTagModel(ctx, &releaseTagState, releaseTag)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &releaseTagModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *WorkflowReleaseTagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var releaseTagPlan *TfWorkflowReleaseTagResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &releaseTagPlan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.WithResource(ctx, "vellum_workflow_release_tag "+releaseTagPlan.Name.ValueString())
+
+	ctx, deadline, diags := timeout.Start(ctx, releaseTagPlan.Timeouts, timeout.Update, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	releaseTag, err := r.client.WorkflowDeployments.UpdateWorkflowReleaseTag(ctx,
+		releaseTagPlan.WorkflowDeploymentId.ValueString(),
+		releaseTagPlan.Name.ValueString(),
+		&vellum.PatchedWorkflowReleaseTagUpdateRequest{
+			HistoryItemId: releaseTagPlan.ReleaseId.ValueStringPointer(),
+		})
+	if err != nil {
+		resp.Diagnostics.AddError("error during workflow release tag update", err.Error())
+		return
+	}
+
+	releaseTagModel, diagnostic := NewTfWorkflowReleaseTagModel(ctx, releaseTagPlan, releaseTag)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &releaseTagModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *WorkflowReleaseTagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var releaseTagState *TfWorkflowReleaseTagResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &releaseTagState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.WithResource(ctx, "vellum_workflow_release_tag "+releaseTagState.Name.ValueString())
+
+	ctx, deadline, diags := timeout.Start(ctx, releaseTagState.Timeouts, timeout.Delete, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	resp.Diagnostics.AddWarning(
+		"Release Tag left in place",
+		fmt.Sprintf("Release Tags can't be deleted, so %q still points at release %s. It has only been removed from Terraform state.",
+			releaseTagState.Name.ValueString(), releaseTagState.ReleaseId.ValueString()),
+	)
+}
+
+func (r *WorkflowReleaseTagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	workflowDeploymentId, name, ok := strings.Cut(req.ID, "/")
+	if !ok || workflowDeploymentId == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected an import identifier of the form <workflow_deployment_id>/<name>, got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("workflow_deployment_id"), workflowDeploymentId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+}
@@ -0,0 +1,132 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	vellumclient "terraform-provider-vellum/internal/sdk/client"
+)
+
+func DataSource() datasource.DataSource {
+	return &WorkspaceDataSource{}
+}
+
+type WorkspaceDataSource struct {
+	client *vellumclient.Client
+}
+
+var _ datasource.DataSource = &WorkspaceDataSource{}
+var _ datasource.DataSourceWithConfigure = &WorkspaceDataSource{}
+
+type TfWorkspaceDataSourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	Name              types.String `tfsdk:"name"`
+	Label             types.String `tfsdk:"label"`
+	OrganizationId    types.String `tfsdk:"organization_id"`
+	OrganizationName  types.String `tfsdk:"organization_name"`
+	ApiKeyId          types.String `tfsdk:"api_key_id"`
+	ApiKeyLabel       types.String `tfsdk:"api_key_label"`
+	ApiKeyScope       types.String `tfsdk:"api_key_scope"`
+	ApiKeyEnvironment types.String `tfsdk:"api_key_environment"`
+}
+
+func (d *WorkspaceDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace"
+}
+
+func (d *WorkspaceDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: "Workspace data source. Reports the workspace the provider's API key authenticates against, " +
+			"so `check` blocks and `precondition`s can fail fast when planning against the wrong workspace.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The workspace's ID",
+				MarkdownDescription: "The workspace's ID",
+			},
+			"name": schema.StringAttribute{
+				Computed:            true,
+				Description:         "A name that uniquely identifies the workspace within its organization",
+				MarkdownDescription: "A name that uniquely identifies the workspace within its organization",
+			},
+			"label": schema.StringAttribute{
+				Computed:            true,
+				Description:         "A human-readable label for the workspace",
+				MarkdownDescription: "A human-readable label for the workspace",
+			},
+			"organization_id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The ID of the organization the workspace belongs to",
+				MarkdownDescription: "The ID of the organization the workspace belongs to",
+			},
+			"organization_name": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The name of the organization the workspace belongs to",
+				MarkdownDescription: "The name of the organization the workspace belongs to",
+			},
+			"api_key_id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The ID of the API key the provider authenticates with",
+				MarkdownDescription: "The ID of the API key the provider authenticates with",
+			},
+			"api_key_label": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The label of the API key the provider authenticates with",
+				MarkdownDescription: "The label of the API key the provider authenticates with",
+			},
+			"api_key_scope": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Whether the API key grants access to a single workspace or to every workspace of its organization\n\n* `WORKSPACE` - Workspace\n* `ORGANIZATION` - Organization",
+				MarkdownDescription: "Whether the API key grants access to a single workspace or to every workspace of its organization\n\n* `WORKSPACE` - Workspace\n* `ORGANIZATION` - Organization",
+			},
+			"api_key_environment": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The environment the API key is restricted to, if any\n\n* `DEVELOPMENT` - Development\n* `STAGING` - Staging\n* `PRODUCTION` - Production",
+				MarkdownDescription: "The environment the API key is restricted to, if any\n\n* `DEVELOPMENT` - Development\n* `STAGING` - Staging\n* `PRODUCTION` - Production",
+			},
+		},
+	}
+}
+
+func (d *WorkspaceDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*vellumclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *WorkspaceDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	workspace, err := d.client.Workspaces.RetrieveCurrent(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read current workspace, got error: %s", err))
+		return
+	}
+
+	workspaceModel, diagnostic := NewTfWorkspaceDataSourceModel(ctx, workspace)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &workspaceModel)...)
+}
@@ -0,0 +1,39 @@
+package workspace
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	vellum "terraform-provider-vellum/internal/sdk"
+)
+
+func NewTfWorkspaceDataSourceModel(ctx context.Context, workspace *vellum.WorkspaceRead) (*TfWorkspaceDataSourceModel, diag.Diagnostics) {
+	workspaceModel := &TfWorkspaceDataSourceModel{
+		Id:                types.StringValue(workspace.Id),
+		Name:              types.StringValue(workspace.Name),
+		Label:             types.StringValue(workspace.Label),
+		OrganizationId:    types.StringNull(),
+		OrganizationName:  types.StringNull(),
+		ApiKeyId:          types.StringNull(),
+		ApiKeyLabel:       types.StringNull(),
+		ApiKeyScope:       types.StringNull(),
+		ApiKeyEnvironment: types.StringNull(),
+	}
+
+	if workspace.Organization != nil {
+		workspaceModel.OrganizationId = types.StringValue(workspace.Organization.Id)
+		workspaceModel.OrganizationName = types.StringValue(workspace.Organization.Name)
+	}
+	if workspace.ApiKey != nil {
+		workspaceModel.ApiKeyId = types.StringValue(workspace.ApiKey.Id)
+		workspaceModel.ApiKeyLabel = types.StringValue(workspace.ApiKey.Label)
+		workspaceModel.ApiKeyScope = types.StringValue(string(workspace.ApiKey.Scope))
+		if workspace.ApiKey.Environment != nil {
+			workspaceModel.ApiKeyEnvironment = types.StringValue(string(*workspace.ApiKey.Environment))
+		}
+	}
+
+	return workspaceModel, nil
+}
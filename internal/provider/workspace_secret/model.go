@@ -0,0 +1,50 @@
+package workspace_secret
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	vellum "terraform-provider-vellum/internal/sdk"
+)
+
+func NewVellumWorkspaceSecretCreateRequest(ctx context.Context, secretModel *TfWorkspaceSecretResourceModel) (*vellum.WorkspaceSecretCreateRequest, diag.Diagnostics) {
+	request := vellum.WorkspaceSecretCreateRequest{
+		Name:  secretModel.Name.ValueString(),
+		Label: secretModel.Label.ValueStringPointer(),
+		Value: secretModel.Value.ValueString(),
+	}
+
+	return &request, nil
+}
+
+// NewVellumWorkspaceSecretUpdateRequest only sends the secret's value when
+// value_version changed, since Vellum never returns it to diff against.
+func NewVellumWorkspaceSecretUpdateRequest(ctx context.Context, secretPlan *TfWorkspaceSecretResourceModel, secretState *TfWorkspaceSecretResourceModel) (*vellum.PatchedWorkspaceSecretUpdateRequest, diag.Diagnostics) {
+	request := vellum.PatchedWorkspaceSecretUpdateRequest{
+		Label: secretPlan.Label.ValueStringPointer(),
+	}
+	if !secretPlan.ValueVersion.Equal(secretState.ValueVersion) {
+		request.Value = secretPlan.Value.ValueStringPointer()
+	}
+
+	return &request, nil
+}
+
+// NewTfWorkspaceSecretModel carries value and value_version over from the
+// given model, since Vellum never returns the secret's value.
+func NewTfWorkspaceSecretModel(ctx context.Context, model *TfWorkspaceSecretResourceModel, secret *vellum.WorkspaceSecretRead) (*TfWorkspaceSecretResourceModel, diag.Diagnostics) {
+	secretModel := &TfWorkspaceSecretResourceModel{
+		Id:           types.StringValue(secret.Id),
+		Name:         types.StringValue(secret.Name),
+		Label:        types.StringValue(secret.Label),
+		Value:        model.Value,
+		ValueVersion: model.ValueVersion,
+		SecretType:   types.StringValue(string(secret.SecretType)),
+		Modified:     types.StringValue(secret.Modified.String()),
+		Timeouts:     model.Timeouts,
+	}
+
+	return secretModel, nil
+}
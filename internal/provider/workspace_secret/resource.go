@@ -0,0 +1,307 @@
+package workspace_secret
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"terraform-provider-vellum/internal/provider/timeout"
+	vellumclient "terraform-provider-vellum/internal/sdk/client"
+	"terraform-provider-vellum/internal/sdk/core"
+)
+
+var _ resource.ResourceWithConfigure = &WorkspaceSecretResource{}
+var _ resource.ResourceWithImportState = &WorkspaceSecretResource{}
+var _ resource.ResourceWithModifyPlan = &WorkspaceSecretResource{}
+
+type WorkspaceSecretResource struct {
+	client *vellumclient.Client
+}
+
+func Resource() resource.Resource {
+	return &WorkspaceSecretResource{}
+}
+
+type TfWorkspaceSecretResourceModel struct {
+	Id           types.String   `tfsdk:"id"`
+	Name         types.String   `tfsdk:"name"`
+	Label        types.String   `tfsdk:"label"`
+	Value        types.String   `tfsdk:"value"`
+	ValueVersion types.Int64    `tfsdk:"value_version"`
+	SecretType   types.String   `tfsdk:"secret_type"`
+	Modified     types.String   `tfsdk:"modified"`
+	Timeouts     timeouts.Value `tfsdk:"timeouts"`
+}
+
+func (r *WorkspaceSecretResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_secret"
+}
+
+func (r *WorkspaceSecretResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Workspace Secret resource. Stores a credential, such as a provider API key, that custom ML Models can reference by name. " +
+			"The secret's value is never read back from Vellum; to rotate it, change `value` together with `value_version`.",
+
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The Workspace Secret's ID",
+				MarkdownDescription: "The Workspace Secret's ID",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "A name that uniquely identifies this Workspace Secret within its workspace",
+				MarkdownDescription: "A name that uniquely identifies this Workspace Secret within its workspace",
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 250),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"label": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "A human-readable label for the Workspace Secret. Defaults to its name.",
+				MarkdownDescription: "A human-readable label for the Workspace Secret. Defaults to its name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"value": schema.StringAttribute{
+				Required:            true,
+				Sensitive:           true,
+				Description:         "The secret's value. It's only sent to Vellum on create and whenever `value_version` changes.",
+				MarkdownDescription: "The secret's value. It's only sent to Vellum on create and whenever `value_version` changes.",
+			},
+			"value_version": schema.Int64Attribute{
+				Optional:            true,
+				Description:         "An arbitrary number to change whenever `value` should be rotated",
+				MarkdownDescription: "An arbitrary number to change whenever `value` should be rotated",
+			},
+			"secret_type": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The kind of Workspace Secret\n\n* `USER_DEFINED` - User Defined\n* `HMAC` - Hmac\n* `INTERNAL_API_KEY` - Internal Api Key",
+				MarkdownDescription: "The kind of Workspace Secret\n\n* `USER_DEFINED` - User Defined\n* `HMAC` - Hmac\n* `INTERNAL_API_KEY` - Internal Api Key",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"modified": schema.StringAttribute{
+				Computed:            true,
+				Description:         "When the Workspace Secret was last modified",
+				MarkdownDescription: "When the Workspace Secret was last modified",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeout.Block(ctx),
+		},
+	}
+}
+
+func (r *WorkspaceSecretResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*vellumclient.Client)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *http.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *WorkspaceSecretResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// Nothing to do when the resource is being created or destroyed.
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var secretPlan *TfWorkspaceSecretResourceModel
+	var secretState *TfWorkspaceSecretResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &secretPlan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &secretState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if secretPlan.Value.IsUnknown() || secretPlan.ValueVersion.IsUnknown() {
+		return
+	}
+	if !secretPlan.Value.Equal(secretState.Value) && secretPlan.ValueVersion.Equal(secretState.ValueVersion) {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("value_version"),
+			"Workspace Secret value will not be rotated",
+			"`value` changed but `value_version` did not, so the new value won't be sent to Vellum. Change `value_version` to rotate the secret.",
+		)
+	}
+}
+
+func (r *WorkspaceSecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var secretPlan *TfWorkspaceSecretResourceModel
+
+	diags := req.Plan.Get(ctx, &secretPlan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.WithResource(ctx, "vellum_workspace_secret "+secretPlan.Name.ValueString())
+
+	ctx, deadline, diags := timeout.Start(ctx, secretPlan.Timeouts, timeout.Create, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	secretRequest, d := NewVellumWorkspaceSecretCreateRequest(ctx, secretPlan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret, err := r.client.WorkspaceSecrets.Create(ctx, secretRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create workspace secret, got error: %s", err))
+		return
+	}
+
+	secretModel, diagnostic := NewTfWorkspaceSecretModel(ctx, secretPlan, secret)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Save data into Terraform state.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &secretModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *WorkspaceSecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var secretState TfWorkspaceSecretResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &secretState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, deadline, diags := timeout.Start(ctx, secretState.Timeouts, timeout.Read, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	secret, err := r.client.WorkspaceSecrets.Retrieve(ctx, secretState.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workspace secret, got error: %s", err))
+		return
+	}
+
+	secretModel, diagnostic := NewTfWorkspaceSecretModel(ctx, &secretState, secret)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &secretModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *WorkspaceSecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var secretPlan *TfWorkspaceSecretResourceModel
+	var secretState *TfWorkspaceSecretResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &secretPlan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &secretState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.WithResource(ctx, "vellum_workspace_secret "+secretPlan.Name.ValueString())
+
+	ctx, deadline, diags := timeout.Start(ctx, secretPlan.Timeouts, timeout.Update, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	secretRequest, d := NewVellumWorkspaceSecretUpdateRequest(ctx, secretPlan, secretState)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	secret, err := r.client.WorkspaceSecrets.PartialUpdate(ctx, secretState.Id.ValueString(), secretRequest)
+	if err != nil {
+		resp.Diagnostics.AddError("error during workspace secret update", err.Error())
+		return
+	}
+
+	secretModel, diagnostic := NewTfWorkspaceSecretModel(ctx, secretPlan, secret)
+	resp.Diagnostics.Append(diagnostic...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &secretModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (r *WorkspaceSecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var secretState *TfWorkspaceSecretResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &secretState)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx = core.WithResource(ctx, "vellum_workspace_secret "+secretState.Name.ValueString())
+
+	ctx, deadline, diags := timeout.Start(ctx, secretState.Timeouts, timeout.Delete, timeout.DefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	defer deadline.End(&resp.Diagnostics)
+
+	err := r.client.WorkspaceSecrets.Destroy(
+		ctx,
+		secretState.Id.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("error when destroying the workspace secret resource", err.Error())
+		return
+	}
+}
+
+func (r *WorkspaceSecretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -5,16 +5,34 @@ import (
 	"terraform-provider-vellum/internal/sdk/mlmodels"
 
 	core "terraform-provider-vellum/internal/sdk/core"
+	deployments "terraform-provider-vellum/internal/sdk/deployments"
 	documentindexes "terraform-provider-vellum/internal/sdk/documentindexes"
+	documents "terraform-provider-vellum/internal/sdk/documents"
+	folderentities "terraform-provider-vellum/internal/sdk/folderentities"
+	folders "terraform-provider-vellum/internal/sdk/folders"
+	search "terraform-provider-vellum/internal/sdk/search"
+	testsuites "terraform-provider-vellum/internal/sdk/testsuites"
+	workflowdeployments "terraform-provider-vellum/internal/sdk/workflowdeployments"
+	workspaces "terraform-provider-vellum/internal/sdk/workspaces"
+	workspacesecrets "terraform-provider-vellum/internal/sdk/workspacesecrets"
 )
 
 type Client struct {
-	baseURL string
-	caller  *core.Caller
-	header  http.Header
+	environment *core.Environment
+	caller      *core.Caller
+	header      http.Header
 
-	DocumentIndexes *documentindexes.Client
-	MLModels        *mlmodels.Client
+	Deployments         *deployments.Client
+	DocumentIndexes     *documentindexes.Client
+	Documents           *documents.Client
+	FolderEntities      *folderentities.Client
+	Folders             *folders.Client
+	MLModels            *mlmodels.Client
+	Search              *search.Client
+	TestSuites          *testsuites.Client
+	WorkflowDeployments *workflowdeployments.Client
+	Workspaces          *workspaces.Client
+	WorkspaceSecrets    *workspacesecrets.Client
 }
 
 func NewClient(opts ...core.ClientOption) *Client {
@@ -22,11 +40,30 @@ func NewClient(opts ...core.ClientOption) *Client {
 	for _, opt := range opts {
 		opt(options)
 	}
+	environment, err := options.ResolveEnvironment()
 	return &Client{
-		baseURL:         options.BaseURL,
-		caller:          core.NewCaller(options.HTTPClient),
-		header:          options.ToHeader(),
-		DocumentIndexes: documentindexes.NewClient(opts...),
-		MLModels:        mlmodels.NewClient(opts...),
+		environment: environment,
+		caller: core.NewCaller(
+			&core.CallerParams{
+				Client:         options.HTTPClient,
+				ReadOnly:       options.ReadOnly,
+				Logging:        options.Logging,
+				TracerProvider: options.TracerProvider,
+				Cache:          options.Cache,
+				Err:            err,
+			},
+		),
+		header:              options.ToHeader(),
+		Deployments:         deployments.NewClient(opts...),
+		DocumentIndexes:     documentindexes.NewClient(opts...),
+		Documents:           documents.NewClient(opts...),
+		FolderEntities:      folderentities.NewClient(opts...),
+		Folders:             folders.NewClient(opts...),
+		MLModels:            mlmodels.NewClient(opts...),
+		Search:              search.NewClient(opts...),
+		TestSuites:          testsuites.NewClient(opts...),
+		WorkflowDeployments: workflowdeployments.NewClient(opts...),
+		Workspaces:          workspaces.NewClient(opts...),
+		WorkspaceSecrets:    workspacesecrets.NewClient(opts...),
 	}
 }
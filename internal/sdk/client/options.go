@@ -3,16 +3,27 @@ package client
 import (
 	http "net/http"
 	core "terraform-provider-vellum/internal/sdk/core"
+
+	trace "go.opentelemetry.io/otel/trace"
 )
 
 // WithBaseURL sets the client's base URL, overriding the
-// default environment, if any.
+// default environment, if any. It's validated by core.NewEnvironment
+// when the client is built, and every call fails if it's invalid.
 func WithBaseURL(baseURL string) core.ClientOption {
 	return func(opts *core.ClientOptions) {
 		opts.BaseURL = baseURL
 	}
 }
 
+// WithEnvironment sends every request to the given environment,
+// such as one returned by core.NewEnvironment, instead of production.
+func WithEnvironment(environment *core.Environment) core.ClientOption {
+	return func(opts *core.ClientOptions) {
+		opts.Environment = environment
+	}
+}
+
 // WithHTTPClient uses the given HTTPClient to issue all HTTP requests.
 func WithHTTPClient(httpClient core.HTTPClient) core.ClientOption {
 	return func(opts *core.ClientOptions) {
@@ -29,6 +40,13 @@ func WithHTTPHeader(httpHeader http.Header) core.ClientOption {
 	}
 }
 
+// WithApiKey sets the apiKey auth header on every request.
+func WithApiKey(apiKey string) core.ClientOption {
+	return func(opts *core.ClientOptions) {
+		opts.ApiKey = apiKey
+	}
+}
+
 // WithApiKeyAndBaseUrl sets the baseUrl and apiKey auth header on every request.
 func WithApiKeyAndBaseUrl(apiKey string, baseUrl string) core.ClientOption {
 	return func(opts *core.ClientOptions) {
@@ -36,3 +54,35 @@ func WithApiKeyAndBaseUrl(apiKey string, baseUrl string) core.ClientOption {
 		opts.BaseURL = baseUrl
 	}
 }
+
+// WithReadOnly makes the client refuse every request that would modify
+// anything, returning core.ErrReadOnly without issuing it.
+func WithReadOnly(readOnly bool) core.ClientOption {
+	return func(opts *core.ClientOptions) {
+		opts.ReadOnly = readOnly
+	}
+}
+
+// WithLogging logs every request issued by the client, and its response,
+// through terraform-plugin-log. Secrets are always redacted.
+func WithLogging(logging bool) core.ClientOption {
+	return func(opts *core.ClientOptions) {
+		opts.Logging = logging
+	}
+}
+
+// WithTracerProvider traces every request issued by the client with the
+// given TracerProvider, instead of the global one.
+func WithTracerProvider(tracerProvider trace.TracerProvider) core.ClientOption {
+	return func(opts *core.ClientOptions) {
+		opts.TracerProvider = tracerProvider
+	}
+}
+
+// WithCache serves GET requests issued by the client from the given Cache,
+// shared by every client built with it.
+func WithCache(cache *core.Cache) core.ClientOption {
+	return func(opts *core.ClientOptions) {
+		opts.Cache = cache
+	}
+}
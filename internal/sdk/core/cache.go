@@ -0,0 +1,234 @@
+package core
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+// DefaultCacheTTL is how long a cached response is served for. It's kept
+// short, since the cache only exists to deduplicate the reads of a single
+// Terraform run.
+const DefaultCacheTTL = 30 * time.Second
+
+// Cache holds the responses to GET requests, shared by every client built
+// with it. Concurrent requests for the same URL are sent once, and their
+// response is served to each of them.
+//
+// A request that may modify anything invalidates every cached response of
+// the same kind of entity, since an entity can be retrieved by its name as
+// well as its ID.
+type Cache struct {
+	ttl   time.Duration
+	now   func() time.Time
+	group singleflight.Group
+
+	mu          sync.Mutex
+	entries     map[string]*cacheEntry
+	generations map[string]uint64
+}
+
+type cacheEntry struct {
+	response   *cachedResponse
+	collection string
+	expires    time.Time
+}
+
+type cachedResponse struct {
+	status     string
+	statusCode int
+	header     http.Header
+	body       []byte
+}
+
+// NewCache returns an empty Cache whose responses expire after the given
+// TTL, or DefaultCacheTTL if it isn't positive.
+func NewCache(ttl time.Duration) *Cache {
+	if ttl <= 0 {
+		ttl = DefaultCacheTTL
+	}
+	return &Cache{
+		ttl:         ttl,
+		now:         time.Now,
+		entries:     map[string]*cacheEntry{},
+		generations: map[string]uint64{},
+	}
+}
+
+type skipCacheContextKey struct{}
+
+// WithoutCache returns a context whose requests bypass the cache, for reads
+// that wait on a change made outside of the provider, such as polling.
+func WithoutCache(ctx context.Context) context.Context {
+	return context.WithValue(ctx, skipCacheContextKey{}, true)
+}
+
+// cachingHTTPClient serves GET requests from its Cache, and invalidates the
+// Cache on every other request.
+type cachingHTTPClient struct {
+	client HTTPClient
+	cache  *Cache
+}
+
+func newCachingHTTPClient(client HTTPClient, cache *Cache) HTTPClient {
+	return &cachingHTTPClient{
+		client: client,
+		cache:  cache,
+	}
+}
+
+func (c *cachingHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	collection := cacheCollection(req)
+	if req.Method != http.MethodGet {
+		resp, err := c.client.Do(req)
+		// Invalidate once the request is done, so that no read issued while
+		// it was in flight outlives it.
+		c.cache.invalidate(collection)
+		return resp, err
+	}
+	if skip, _ := req.Context().Value(skipCacheContextKey{}).(bool); skip {
+		return c.client.Do(req)
+	}
+
+	key := req.URL.String()
+	if response, ok := c.cache.get(key); ok {
+		return response.toResponse(req), nil
+	}
+
+	// Requests joining an in-flight one share its result, unless the Cache
+	// was invalidated since it was sent. The shared request outlives the
+	// context of the request that sent it, so that one caller's timeout or
+	// cancellation doesn't fail the others, which each wait on their own.
+	generation := c.cache.generation(collection)
+	shared := req.Clone(context.WithoutCancel(req.Context()))
+	results := c.cache.group.DoChan(fmt.Sprintf("%d:%s", generation, key), func() (interface{}, error) {
+		resp, err := c.client.Do(shared)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			return nil, err
+		}
+		response := &cachedResponse{
+			status:     resp.Status,
+			statusCode: resp.StatusCode,
+			header:     resp.Header.Clone(),
+			body:       body,
+		}
+		if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+			c.cache.set(key, collection, generation, response)
+		}
+		return response, nil
+	})
+
+	select {
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	case result := <-results:
+		if result.Err != nil {
+			return nil, result.Err
+		}
+		return result.Val.(*cachedResponse).toResponse(req), nil
+	}
+}
+
+func (c *Cache) get(key string) (*cachedResponse, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return nil, false
+	}
+	if c.now().After(entry.expires) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.response, true
+}
+
+// set caches the response, unless its collection was invalidated since the
+// request was sent.
+func (c *Cache) set(key string, collection string, generation uint64, response *cachedResponse) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.generations[collection] != generation {
+		return
+	}
+	c.entries[key] = &cacheEntry{
+		response:   response,
+		collection: collection,
+		expires:    c.now().Add(c.ttl),
+	}
+}
+
+func (c *Cache) generation(collection string) uint64 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.generations[collection]
+}
+
+// invalidate drops every cached response of the given collection.
+func (c *Cache) invalidate(collection string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.generations[collection]++
+	for key, entry := range c.entries {
+		if entry.collection == collection {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// toResponse returns a response to the given request, with its own copy of
+// the body.
+func (r *cachedResponse) toResponse(req *http.Request) *http.Response {
+	return &http.Response{
+		Status:        r.status,
+		StatusCode:    r.statusCode,
+		Proto:         "HTTP/1.1",
+		ProtoMajor:    1,
+		ProtoMinor:    1,
+		Header:        r.header.Clone(),
+		Body:          io.NopCloser(bytes.NewReader(r.body)),
+		ContentLength: int64(len(r.body)),
+		Request:       req,
+	}
+}
+
+// collectionAliases maps the collections of endpoints that modify another
+// collection's entities to that collection.
+var collectionAliases = map[string]string{
+	"upload-document": "documents",
+}
+
+// cacheCollection returns the kind of entity a request addresses, such as
+// document-indexes for /v1/document-indexes/{id}. The host is left out, since
+// an Environment serves the same entities from several hosts.
+func cacheCollection(req *http.Request) string {
+	segments := strings.Split(strings.Trim(req.URL.Path, "/"), "/")
+	collection := segments[0]
+	for i, segment := range segments {
+		if segment == "v1" && i+1 < len(segments) {
+			collection = segments[i+1]
+			break
+		}
+	}
+	if alias, ok := collectionAliases[collection]; ok {
+		collection = alias
+	}
+	return collection
+}
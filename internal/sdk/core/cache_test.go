@@ -0,0 +1,213 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// httpClientFunc is an HTTPClient backed by a function.
+type httpClientFunc func(*http.Request) (*http.Response, error)
+
+func (f httpClientFunc) Do(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+// countingClient responds to every request with its path, counting the
+// requests it receives by method.
+type countingClient struct {
+	gets  atomic.Int32
+	posts atomic.Int32
+}
+
+func (c *countingClient) Do(req *http.Request) (*http.Response, error) {
+	if req.Method == http.MethodGet {
+		c.gets.Add(1)
+	} else {
+		c.posts.Add(1)
+	}
+	return newTestResponse(http.StatusOK, req.URL.Path), nil
+}
+
+func newTestResponse(statusCode int, body string) *http.Response {
+	return &http.Response{
+		StatusCode: statusCode,
+		Header:     http.Header{},
+		Body:       io.NopCloser(strings.NewReader(body)),
+	}
+}
+
+func doRequest(t *testing.T, ctx context.Context, client HTTPClient, method string, url string) (string, error) {
+	t.Helper()
+	req, err := http.NewRequestWithContext(ctx, method, url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(body), nil
+}
+
+func TestCacheDeduplicatesConcurrentRequests(t *testing.T) {
+	release := make(chan struct{})
+	var calls atomic.Int32
+	client := newCachingHTTPClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		calls.Add(1)
+		<-release
+		return newTestResponse(http.StatusOK, "index"), nil
+	}), NewCache(time.Minute))
+
+	var wg sync.WaitGroup
+	for i := 0; i < 20; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			body, err := doRequest(t, context.Background(), client, http.MethodGet, "https://api.vellum.ai/v1/document-indexes/a")
+			if err != nil || body != "index" {
+				t.Errorf("got %q, %v, want index", body, err)
+			}
+		}()
+	}
+	close(release)
+	wg.Wait()
+
+	if got := calls.Load(); got != 1 {
+		t.Errorf("sent %d requests, want 1", got)
+	}
+}
+
+func TestCacheWaitersOutliveTheSendersContext(t *testing.T) {
+	sent := make(chan struct{})
+	release := make(chan struct{})
+	client := newCachingHTTPClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		close(sent)
+		<-release
+		if err := req.Context().Err(); err != nil {
+			return nil, err
+		}
+		return newTestResponse(http.StatusOK, "index"), nil
+	}), NewCache(time.Minute))
+
+	senderCtx, cancelSender := context.WithCancel(context.Background())
+	senderErr := make(chan error)
+	go func() {
+		_, err := doRequest(t, senderCtx, client, http.MethodGet, "https://api.vellum.ai/v1/document-indexes/a")
+		senderErr <- err
+	}()
+	<-sent
+
+	waiterCtx, cancelWaiter := context.WithCancel(context.Background())
+	cancelWaiter()
+	if _, err := doRequest(t, waiterCtx, client, http.MethodGet, "https://api.vellum.ai/v1/document-indexes/a"); !errors.Is(err, context.Canceled) {
+		t.Errorf("cancelled waiter got %v, want %v", err, context.Canceled)
+	}
+
+	waiterBody := make(chan string)
+	go func() {
+		body, err := doRequest(t, context.Background(), client, http.MethodGet, "https://api.vellum.ai/v1/document-indexes/a")
+		if err != nil {
+			t.Errorf("waiter got %v", err)
+		}
+		waiterBody <- body
+	}()
+
+	cancelSender()
+	if err := <-senderErr; !errors.Is(err, context.Canceled) {
+		t.Errorf("cancelled sender got %v, want %v", err, context.Canceled)
+	}
+	close(release)
+	if body := <-waiterBody; body != "index" {
+		t.Errorf("waiter got %q, want index", body)
+	}
+}
+
+func TestCacheExpiresResponses(t *testing.T) {
+	now := time.Now()
+	cache := NewCache(time.Minute)
+	cache.now = func() time.Time { return now }
+	counting := &countingClient{}
+	client := newCachingHTTPClient(counting, cache)
+
+	for i := 0; i < 2; i++ {
+		if _, err := doRequest(t, context.Background(), client, http.MethodGet, "https://api.vellum.ai/v1/ml-models/a"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := counting.gets.Load(); got != 1 {
+		t.Fatalf("sent %d requests within the TTL, want 1", got)
+	}
+
+	now = now.Add(time.Minute + time.Second)
+	if _, err := doRequest(t, context.Background(), client, http.MethodGet, "https://api.vellum.ai/v1/ml-models/a"); err != nil {
+		t.Fatal(err)
+	}
+	if got := counting.gets.Load(); got != 2 {
+		t.Errorf("sent %d requests after the TTL, want 2", got)
+	}
+}
+
+func TestCacheInvalidatesCollectionOnModification(t *testing.T) {
+	counting := &countingClient{}
+	client := newCachingHTTPClient(counting, NewCache(time.Minute))
+
+	get := func(url string) {
+		t.Helper()
+		if _, err := doRequest(t, context.Background(), client, http.MethodGet, url); err != nil {
+			t.Fatal(err)
+		}
+	}
+	get("https://api.vellum.ai/v1/document-indexes/a")
+	get("https://api.vellum.ai/v1/ml-models/a")
+
+	if _, err := doRequest(t, context.Background(), client, http.MethodPatch, "https://api.vellum.ai/v1/document-indexes/b"); err != nil {
+		t.Fatal(err)
+	}
+	get("https://api.vellum.ai/v1/document-indexes/a")
+	get("https://api.vellum.ai/v1/ml-models/a")
+	if got := counting.gets.Load(); got != 3 {
+		t.Errorf("sent %d requests, want 3: the document index twice and the ML model once", got)
+	}
+
+	// Uploads are served from another host, but modify Documents.
+	get("https://api.vellum.ai/v1/documents/a")
+	if _, err := doRequest(t, context.Background(), client, http.MethodPost, "https://documents.vellum.ai/v1/upload-document"); err != nil {
+		t.Fatal(err)
+	}
+	get("https://api.vellum.ai/v1/documents/a")
+	if got := counting.gets.Load(); got != 5 {
+		t.Errorf("sent %d requests, want 5 once the document is read again after its upload", got)
+	}
+}
+
+func TestCacheSkipsFailedResponsesAndWithoutCache(t *testing.T) {
+	var calls atomic.Int32
+	client := newCachingHTTPClient(httpClientFunc(func(req *http.Request) (*http.Response, error) {
+		calls.Add(1)
+		return newTestResponse(http.StatusNotFound, "not found"), nil
+	}), NewCache(time.Minute))
+
+	for i := 0; i < 2; i++ {
+		if _, err := doRequest(t, context.Background(), client, http.MethodGet, "https://api.vellum.ai/v1/folders/a"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := doRequest(t, WithoutCache(context.Background()), client, http.MethodGet, "https://api.vellum.ai/v1/folders/a"); err != nil {
+		t.Fatal(err)
+	}
+	if got := calls.Load(); got != 3 {
+		t.Errorf("sent %d requests, want 3", got)
+	}
+}
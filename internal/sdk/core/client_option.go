@@ -3,6 +3,8 @@ package core
 import (
 	fmt "fmt"
 	http "net/http"
+
+	trace "go.opentelemetry.io/otel/trace"
 )
 
 // ClientOption adapts the behavior of the generated client.
@@ -12,10 +14,16 @@ type ClientOption func(*ClientOptions)
 // This type is primarily used by the generated code and is
 // not meant to be used directly; use ClientOption instead.
 type ClientOptions struct {
-	BaseURL    string
-	HTTPClient HTTPClient
-	HTTPHeader http.Header
-	ApiKey     string
+	BaseURL     string
+	Environment *Environment
+	HTTPClient  HTTPClient
+	HTTPHeader  http.Header
+	ApiKey      string
+	ReadOnly    bool
+	Logging     bool
+
+	TracerProvider trace.TracerProvider
+	Cache          *Cache
 }
 
 // NewClientOptions returns a new *ClientOptions value.
@@ -28,6 +36,24 @@ func NewClientOptions() *ClientOptions {
 	}
 }
 
+// ResolveEnvironment returns the Environment requests are sent to: one
+// serving every service from BaseURL, if set, otherwise Environment,
+// defaulting to ProductionEnvironment. An invalid BaseURL resolves to an
+// empty Environment along with the error it failed validation with.
+func (c *ClientOptions) ResolveEnvironment() (*Environment, error) {
+	if c.BaseURL != "" {
+		environment, err := NewEnvironment(c.BaseURL)
+		if err != nil {
+			return &Environment{}, err
+		}
+		return environment, nil
+	}
+	if c.Environment != nil {
+		return c.Environment, nil
+	}
+	return ProductionEnvironment, nil
+}
+
 // ToHeader maps the configured client options into a http.Header issued
 // on every request.
 func (c *ClientOptions) ToHeader() http.Header {
@@ -9,6 +9,8 @@ import (
 	"io"
 	"mime/multipart"
 	"net/http"
+
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -76,15 +78,65 @@ func (a *APIError) Error() string {
 // typed API error (e.g. *APIError).
 type ErrorDecoder func(statusCode int, body io.Reader) error
 
+// ErrReadOnly is returned, without the request being issued, for every
+// call a read-only Caller refuses to make.
+var ErrReadOnly = errors.New("the client is read-only")
+
+type resourceContextKey struct{}
+
+// WithResource returns a context whose calls are attributed to the given
+// resource, such as "vellum_document_index my-index", in the errors they
+// return without issuing their request.
+func WithResource(ctx context.Context, resource string) context.Context {
+	return context.WithValue(ctx, resourceContextKey{}, resource)
+}
+
 // Caller calls APIs and deserializes their response, if any.
 type Caller struct {
-	client HTTPClient
+	client   HTTPClient
+	readOnly bool
+	err      error
+}
+
+// CallerParams represents the parameters used to construct a new *Caller.
+type CallerParams struct {
+	Client HTTPClient
+
+	// ReadOnly rejects every call that isn't a GET, unless the call is
+	// marked as safe.
+	ReadOnly bool
+
+	// Logging logs every request through terraform-plugin-log, under the
+	// LogSubsystem subsystem.
+	Logging bool
+
+	// TracerProvider traces every request, defaulting to the global
+	// OpenTelemetry TracerProvider.
+	TracerProvider trace.TracerProvider
+
+	// Cache, if any, serves GET requests from the given Cache. Responses
+	// served from it aren't logged or traced.
+	Cache *Cache
+
+	// Err, if any, is returned by every call without issuing its request,
+	// such as when the client was built with an invalid base URL.
+	Err error
 }
 
-// NewCaller returns a new *Caller backed by the given HTTP client.
-func NewCaller(client HTTPClient) *Caller {
+// NewCaller returns a new *Caller backed by the given parameters.
+func NewCaller(params *CallerParams) *Caller {
+	client := params.Client
+	if params.Logging {
+		client = newLoggingHTTPClient(client)
+	}
+	client = newTracingHTTPClient(client, params.TracerProvider)
+	if params.Cache != nil {
+		client = newCachingHTTPClient(client, params.Cache)
+	}
 	return &Caller{
-		client: client,
+		client:   client,
+		readOnly: params.ReadOnly,
+		err:      params.Err,
 	}
 }
 
@@ -97,14 +149,29 @@ type CallParams struct {
 	Response           interface{}
 	ResponseIsOptional bool
 	ErrorDecoder       ErrorDecoder
+
+	// Safe marks a call that doesn't modify anything even though it
+	// isn't a GET, such as a search, so read-only Callers still make it.
+	Safe bool
 }
 
 // Call issues an API call according to the given call parameters.
 func (c *Caller) Call(ctx context.Context, params *CallParams) error {
+	if c.err != nil {
+		return c.err
+	}
+	if c.readOnly && !params.Safe && !isReadMethod(params.Method) {
+		if resource, _ := ctx.Value(resourceContextKey{}).(string); resource != "" {
+			return fmt.Errorf("%s: %w, so it refused to %s %s", resource, ErrReadOnly, params.Method, params.URL)
+		}
+		return fmt.Errorf("%w, so it refused to %s %s", ErrReadOnly, params.Method, params.URL)
+	}
+
 	req, err := newRequest(ctx, params.URL, params.Method, params.Headers, params.Request)
 	if err != nil {
 		return err
 	}
+	setIdempotencyKey(req)
 
 	// If the call has been cancelled, don't issue the request.
 	if err := ctx.Err(); err != nil {
@@ -152,6 +219,15 @@ func (c *Caller) Call(ctx context.Context, params *CallParams) error {
 	return nil
 }
 
+// isReadMethod reports whether the given HTTP method only reads data.
+func isReadMethod(method string) bool {
+	switch method {
+	case "", http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
 // newRequest returns a new *http.Request with all of the fields
 // required to issue the call.
 func newRequest(
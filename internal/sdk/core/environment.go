@@ -0,0 +1,63 @@
+package core
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// Environment is a deployment of the Vellum API, made of the base URL each
+// of its services is served from. Base URLs never end with a slash.
+type Environment struct {
+	Default   string
+	Predict   string
+	Documents string
+}
+
+// ProductionEnvironment is the Vellum API clients call by default.
+var ProductionEnvironment = &Environment{
+	Default:   "https://api.vellum.ai",
+	Predict:   "https://predict.vellum.ai",
+	Documents: "https://documents.vellum.ai",
+}
+
+// NewEnvironment returns an Environment serving every service from the given
+// base URL, such as a self-hosted Vellum, or an error if it isn't an
+// absolute http or https URL.
+func NewEnvironment(baseURL string) (*Environment, error) {
+	parsed, err := url.Parse(strings.TrimSpace(baseURL))
+	if err != nil {
+		return nil, fmt.Errorf("invalid base URL %q: %w", baseURL, err)
+	}
+	if parsed.Scheme != "http" && parsed.Scheme != "https" || parsed.Host == "" {
+		return nil, fmt.Errorf("invalid base URL %q: expected an absolute http or https URL, such as https://api.vellum.ai", baseURL)
+	}
+	if parsed.RawQuery != "" || parsed.Fragment != "" || strings.Contains(parsed.String(), "%") {
+		return nil, fmt.Errorf("invalid base URL %q: expected no query, fragment or escaped characters", baseURL)
+	}
+
+	normalized := strings.TrimRight(parsed.String(), "/")
+	return &Environment{
+		Default:   normalized,
+		Predict:   normalized,
+		Documents: normalized,
+	}, nil
+}
+
+// WithPathPrefix returns a copy of the Environment whose services are served
+// under the given path prefix, such as by a reverse proxy, or an error if
+// the prefix isn't a plain path.
+func (e *Environment) WithPathPrefix(prefix string) (*Environment, error) {
+	prefix = strings.Trim(strings.TrimSpace(prefix), "/")
+	if prefix == "" {
+		return e, nil
+	}
+	if strings.ContainsAny(prefix, "?#% \t\n") || strings.Contains(prefix, "//") {
+		return nil, fmt.Errorf("invalid path prefix %q: expected a path such as /vellum", prefix)
+	}
+	return &Environment{
+		Default:   e.Default + "/" + prefix,
+		Predict:   e.Predict + "/" + prefix,
+		Documents: e.Documents + "/" + prefix,
+	}, nil
+}
@@ -0,0 +1,29 @@
+package core
+
+import (
+	"context"
+	"net/http"
+)
+
+// idempotencyKeyHeader is the header idempotency keys are sent under.
+const idempotencyKeyHeader = "Idempotency-Key"
+
+type idempotencyKeyContextKey struct{}
+
+// WithIdempotencyKey returns a context whose POST requests carry the given
+// idempotency key, so that the server can recognize a retried request it
+// already committed. Only pass it to the one call the key belongs to.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyKeyContextKey{}, key)
+}
+
+// setIdempotencyKey sets the request's idempotency key, if its context
+// carries one.
+func setIdempotencyKey(req *http.Request) {
+	if req.Method != http.MethodPost {
+		return
+	}
+	if key, ok := req.Context().Value(idempotencyKeyContextKey{}).(string); ok && key != "" {
+		req.Header.Set(idempotencyKeyHeader, key)
+	}
+}
@@ -0,0 +1,243 @@
+package core
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"mime"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// LogSubsystem is the terraform-plugin-log subsystem API calls are logged
+// under. Its level follows TF_LOG_PROVIDER_VELLUM_API, falling back to the
+// provider's.
+const LogSubsystem = "vellum_api"
+
+const (
+	redacted        = "[REDACTED]"
+	apiKeyHeader    = "X_API_KEY"
+	requestIdHeader = "X-Request-Id"
+)
+
+// loggingHTTPClient logs every request it issues, along with the response's
+// status, latency and request ID, at DEBUG. JSON bodies are logged at TRACE.
+//
+// The API key and secrets are always redacted: the API key header and its
+// value anywhere in a log entry, the headers and authorization of an ML
+// Model's request_config, and the values of Workspace Secrets.
+type loggingHTTPClient struct {
+	client HTTPClient
+
+	// logBodies is set when bodies may be logged, so that they're only
+	// copied and parsed when they are.
+	logBodies bool
+}
+
+func newLoggingHTTPClient(client HTTPClient) HTTPClient {
+	return &loggingHTTPClient{
+		client:    client,
+		logBodies: traceEnabled(),
+	}
+}
+
+// traceEnabled reports whether the subsystem logs at TRACE, according to the
+// environment variables its level is taken from, most specific first.
+// terraform-plugin-log doesn't expose the level a logger was built with.
+func traceEnabled() bool {
+	for _, name := range []string{"TF_LOG_PROVIDER_VELLUM_API", "TF_LOG_PROVIDER_VELLUM", "TF_LOG_PROVIDER", "TF_LOG"} {
+		if level := strings.ToUpper(strings.TrimSpace(os.Getenv(name))); level != "" {
+			// TF_LOG=JSON logs everything, as JSON.
+			return level == "TRACE" || level == "JSON"
+		}
+	}
+	return false
+}
+
+func (l *loggingHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	ctx := tflog.NewSubsystem(req.Context(), LogSubsystem, tflog.WithLevelFromEnv("TF_LOG_PROVIDER", LogSubsystem))
+	if apiKey := req.Header.Get(apiKeyHeader); apiKey != "" {
+		ctx = tflog.SubsystemMaskAllFieldValuesStrings(ctx, LogSubsystem, apiKey)
+	}
+
+	fields := map[string]interface{}{
+		"method": req.Method,
+		"url":    req.URL.String(),
+	}
+	tflog.SubsystemDebug(ctx, LogSubsystem, "sending Vellum API request", fields)
+	if l.logBodies {
+		if body := requestBody(req); body != nil {
+			tflog.SubsystemTrace(ctx, LogSubsystem, "Vellum API request body", map[string]interface{}{
+				"method":  req.Method,
+				"url":     req.URL.String(),
+				"headers": redactHeader(req.Header),
+				"body":    redactBody(req.URL.Path, body),
+			})
+		}
+	}
+
+	start := time.Now()
+	resp, err := l.client.Do(req)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.SubsystemDebug(ctx, LogSubsystem, "Vellum API request failed", fields)
+		return resp, err
+	}
+
+	fields["status"] = resp.StatusCode
+	fields["request_id"] = resp.Header.Get(requestIdHeader)
+	tflog.SubsystemDebug(ctx, LogSubsystem, "received Vellum API response", fields)
+	if l.logBodies {
+		if body := responseBody(resp); body != nil {
+			tflog.SubsystemTrace(ctx, LogSubsystem, "Vellum API response body", map[string]interface{}{
+				"method":     req.Method,
+				"url":        req.URL.String(),
+				"status":     resp.StatusCode,
+				"request_id": resp.Header.Get(requestIdHeader),
+				"body":       redactBody(req.URL.Path, body),
+			})
+		}
+	}
+	return resp, nil
+}
+
+// requestBody returns a copy of the request's body if it's JSON, leaving the
+// request itself untouched.
+func requestBody(req *http.Request) []byte {
+	if req.GetBody == nil || !isJSON(req.Header) {
+		return nil
+	}
+	body, err := req.GetBody()
+	if err != nil {
+		return nil
+	}
+	defer body.Close()
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+// responseBody reads the response's body if it's JSON, replacing it with an
+// identical one so it can still be decoded.
+func responseBody(resp *http.Response) []byte {
+	if resp.Body == nil || !isJSON(resp.Header) {
+		return nil
+	}
+	data, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(io.MultiReader(bytes.NewReader(data), errReader{err}))
+	if err != nil {
+		return nil
+	}
+	return data
+}
+
+// errReader replays the error, if any, that cut a response body short.
+type errReader struct {
+	err error
+}
+
+func (e errReader) Read([]byte) (int, error) {
+	if e.err != nil {
+		return 0, e.err
+	}
+	return 0, io.EOF
+}
+
+func isJSON(header http.Header) bool {
+	mediaType, _, err := mime.ParseMediaType(header.Get(contentTypeHeader))
+	return err == nil && (mediaType == contentType || strings.HasSuffix(mediaType, "+json"))
+}
+
+// secretHeaders are the canonical names of the headers that carry
+// credentials.
+var secretHeaders = map[string]bool{
+	http.CanonicalHeaderKey(apiKeyHeader):    true,
+	http.CanonicalHeaderKey("X-API-KEY"):     true,
+	http.CanonicalHeaderKey("Authorization"): true,
+}
+
+func redactHeader(header http.Header) map[string]string {
+	redactedHeader := map[string]string{}
+	for name := range header {
+		if secretHeaders[http.CanonicalHeaderKey(name)] {
+			redactedHeader[name] = redacted
+			continue
+		}
+		redactedHeader[name] = header.Get(name)
+	}
+	return redactedHeader
+}
+
+// redactBody returns the JSON body with its secrets redacted, or the body
+// as-is if it isn't valid JSON.
+func redactBody(urlPath string, body []byte) string {
+	var value interface{}
+	if err := json.Unmarshal(body, &value); err != nil {
+		return string(body)
+	}
+
+	if strings.Contains(urlPath, "/workspace-secrets") {
+		redactSecretValues(value)
+	}
+	redactRequestConfigs(value)
+
+	redactedBody, err := json.Marshal(value)
+	if err != nil {
+		return string(body)
+	}
+	return string(redactedBody)
+}
+
+// redactSecretValues redacts the value of every Workspace Secret within the
+// given JSON value, whether it's a single Workspace Secret or a page of them.
+func redactSecretValues(value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if key == "value" {
+				v[key] = redacted
+				continue
+			}
+			redactSecretValues(child)
+		}
+	case []interface{}:
+		for _, child := range v {
+			redactSecretValues(child)
+		}
+	}
+}
+
+// redactRequestConfigs redacts the headers and authorization of every
+// request_config within the given JSON value, wherever it's nested.
+func redactRequestConfigs(value interface{}) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, child := range v {
+			if requestConfig, ok := child.(map[string]interface{}); ok && key == "request_config" {
+				for _, field := range []string{"headers", "authorization"} {
+					if secrets, ok := requestConfig[field].(map[string]interface{}); ok {
+						for name := range secrets {
+							if field == "authorization" && name == "type" {
+								continue
+							}
+							secrets[name] = redacted
+						}
+					}
+				}
+			}
+			redactRequestConfigs(child)
+		}
+	case []interface{}:
+		for _, child := range v {
+			redactRequestConfigs(child)
+		}
+	}
+}
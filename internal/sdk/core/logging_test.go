@@ -0,0 +1,115 @@
+package core
+
+import (
+	"encoding/json"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRedactHeader(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		value  string
+		want   string
+	}{
+		{name: "api key as sent", header: apiKeyHeader, value: "key", want: redacted},
+		{name: "api key with dashes", header: "X-API-KEY", value: "key", want: redacted},
+		{name: "authorization", header: "Authorization", value: "Bearer key", want: redacted},
+		{name: "content type", header: contentTypeHeader, value: contentType, want: contentType},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			header := http.Header{}
+			header.Set(test.header, test.value)
+			got := redactHeader(header)
+			if len(got) != 1 {
+				t.Fatalf("got %v, want a single header", got)
+			}
+			for name, value := range got {
+				if value != test.want {
+					t.Errorf("got %s: %q, want %q", name, value, test.want)
+				}
+			}
+		})
+	}
+}
+
+func TestRedactBody(t *testing.T) {
+	tests := []struct {
+		name string
+		path string
+		body string
+		want string
+	}{
+		{
+			name: "workspace secret create",
+			path: "/v1/workspace-secrets",
+			body: `{"name":"openai","label":"OpenAI","value":"sk-secret"}`,
+			want: `{"name":"openai","label":"OpenAI","value":"[REDACTED]"}`,
+		},
+		{
+			name: "workspace secret retrieve",
+			path: "/v1/workspace-secrets/openai",
+			body: `{"id":"1","name":"openai","label":"OpenAI","secret_type":"USER_DEFINED","value":"sk-secret"}`,
+			want: `{"id":"1","name":"openai","label":"OpenAI","secret_type":"USER_DEFINED","value":"[REDACTED]"}`,
+		},
+		{
+			name: "workspace secret list",
+			path: "/v1/workspace-secrets",
+			body: `{"count":2,"next":null,"results":[{"name":"a","value":"sk-a"},{"name":"b","value":"sk-b"}]}`,
+			want: `{"count":2,"next":null,"results":[{"name":"a","value":"[REDACTED]"},{"name":"b","value":"[REDACTED]"}]}`,
+		},
+		{
+			name: "workspace secret nested",
+			path: "/v1/workspace-secrets/openai",
+			body: `{"secret":{"name":"openai","value":{"token":"sk-secret"}}}`,
+			want: `{"secret":{"name":"openai","value":"[REDACTED]"}}`,
+		},
+		{
+			name: "ML model request config",
+			path: "/v1/ml-models",
+			body: `{"name":"model","exec_config":{"request_config":{"headers":{"X-Org":"org-secret"},"authorization":{"type":"API_KEY","value":"sk-secret","header_name":"X-Key"}}}}`,
+			want: `{"name":"model","exec_config":{"request_config":{"headers":{"X-Org":"[REDACTED]"},"authorization":{"type":"API_KEY","value":"[REDACTED]","header_name":"[REDACTED]"}}}}`,
+		},
+		{
+			name: "ML model list request config",
+			path: "/v1/ml-models",
+			body: `{"results":[{"exec_config":{"request_config":{"headers":{"X-Org":"org-secret"}}}}]}`,
+			want: `{"results":[{"exec_config":{"request_config":{"headers":{"X-Org":"[REDACTED]"}}}}]}`,
+		},
+		{
+			name: "values outside workspace secrets",
+			path: "/v1/document-indexes",
+			body: `{"name":"index","value":"kept"}`,
+			want: `{"name":"index","value":"kept"}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := redactBody(test.path, []byte(test.body))
+			if strings.Contains(got, "sk-") || strings.Contains(got, "org-secret") {
+				t.Errorf("got %s, which leaks a secret", got)
+			}
+
+			var gotValue, wantValue interface{}
+			if err := json.Unmarshal([]byte(got), &gotValue); err != nil {
+				t.Fatalf("got invalid JSON %s: %v", got, err)
+			}
+			if err := json.Unmarshal([]byte(test.want), &wantValue); err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(gotValue, wantValue) {
+				t.Errorf("got %s, want %s", got, test.want)
+			}
+		})
+	}
+}
+
+func TestRedactBodyKeepsInvalidJSON(t *testing.T) {
+	if got := redactBody("/v1/workspace-secrets", []byte("not json")); got != "not json" {
+		t.Errorf("got %q, want the body as-is", got)
+	}
+}
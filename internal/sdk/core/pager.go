@@ -0,0 +1,143 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"fmt"
+)
+
+// ErrPaginationLoop is returned when a paginated endpoint hands back a
+// next page URL that has already been visited.
+var ErrPaginationLoop = errors.New("pagination loop detected")
+
+// ErrEmptyPage is returned when a paginated endpoint responds with no page,
+// such as a null body.
+var ErrEmptyPage = errors.New("no page was returned")
+
+// Page is a single page of results returned by a paginated endpoint.
+type Page[T any] struct {
+	Results []T
+	// Next is the URL of the following page, or empty on the last page.
+	Next string
+}
+
+// PageFunc fetches the page served at the given URL.
+type PageFunc[T any] func(ctx context.Context, url string) (*Page[T], error)
+
+// PageOption adapts the behavior of a Pager.
+type PageOption func(*PageOptions)
+
+// PageOptions defines all of the possible pager options.
+type PageOptions struct {
+	// MaxItems caps the total number of results returned across all
+	// pages. Zero means no cap.
+	MaxItems int
+}
+
+// WithMaxItems stops pagination once the given number of results has
+// been returned.
+func WithMaxItems(maxItems int) PageOption {
+	return func(opts *PageOptions) {
+		opts.MaxItems = maxItems
+	}
+}
+
+// Pager walks a paginated endpoint by following the Next URL of every
+// page until there are no more pages, the context is cancelled, or the
+// MaxItems cap is reached.
+//
+//	pager := client.DocumentIndexes.Pages(request)
+//	for pager.Next(ctx) {
+//		for _, documentIndex := range pager.Results() {
+//			...
+//		}
+//	}
+//	if err := pager.Err(); err != nil {
+//		...
+//	}
+type Pager[T any] struct {
+	fetch    PageFunc[T]
+	next     string
+	seen     map[string]struct{}
+	maxItems int
+	count    int
+	results  []T
+	err      error
+}
+
+// NewPager returns a new *Pager that starts at the given URL.
+func NewPager[T any](url string, fetch PageFunc[T], opts ...PageOption) *Pager[T] {
+	options := &PageOptions{}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return &Pager[T]{
+		fetch:    fetch,
+		next:     url,
+		seen:     make(map[string]struct{}),
+		maxItems: options.MaxItems,
+	}
+}
+
+// Next fetches the next page. It returns false when there are no more
+// pages or an error occurred; call Err to tell the two apart.
+func (p *Pager[T]) Next(ctx context.Context) bool {
+	p.results = nil
+	if p.err != nil || p.next == "" {
+		return false
+	}
+	if p.maxItems > 0 && p.count >= p.maxItems {
+		return false
+	}
+	if err := ctx.Err(); err != nil {
+		p.err = err
+		return false
+	}
+	if _, ok := p.seen[p.next]; ok {
+		p.err = fmt.Errorf("%w: %s was already visited", ErrPaginationLoop, p.next)
+		return false
+	}
+	p.seen[p.next] = struct{}{}
+
+	page, err := p.fetch(ctx, p.next)
+	if err != nil {
+		p.err = err
+		return false
+	}
+	if page == nil {
+		p.err = fmt.Errorf("%w: %s", ErrEmptyPage, p.next)
+		return false
+	}
+
+	results := page.Results
+	if p.maxItems > 0 && p.count+len(results) > p.maxItems {
+		results = results[:p.maxItems-p.count]
+	}
+	p.results = results
+	p.count += len(results)
+	p.next = page.Next
+	return true
+}
+
+// Results returns the results of the current page.
+func (p *Pager[T]) Results() []T {
+	return p.results
+}
+
+// Err returns the error that stopped pagination, if any.
+func (p *Pager[T]) Err() error {
+	return p.err
+}
+
+// All drains the pager and returns the results of every remaining page,
+// which are empty but never nil when there are none.
+func (p *Pager[T]) All(ctx context.Context) ([]T, error) {
+	results := []T{}
+	for p.Next(ctx) {
+		results = append(results, p.Results()...)
+	}
+	if err := p.Err(); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
@@ -0,0 +1,152 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+// pages serves the given pages by URL, counting the pages fetched.
+type pages struct {
+	pages   map[string]*Page[int]
+	fetched []string
+}
+
+func (p *pages) fetch(ctx context.Context, url string) (*Page[int], error) {
+	p.fetched = append(p.fetched, url)
+	page, ok := p.pages[url]
+	if !ok {
+		return nil, errors.New("not found: " + url)
+	}
+	return page, nil
+}
+
+func TestPagerFollowsNextPages(t *testing.T) {
+	p := &pages{pages: map[string]*Page[int]{
+		"/1": {Results: []int{1, 2}, Next: "/2"},
+		"/2": {Results: []int{3}, Next: "/3"},
+		"/3": {Results: []int{4, 5}},
+	}}
+
+	got, err := NewPager("/1", p.fetch).All(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []int{1, 2, 3, 4, 5}; !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if want := []string{"/1", "/2", "/3"}; !reflect.DeepEqual(p.fetched, want) {
+		t.Errorf("fetched %v, want %v", p.fetched, want)
+	}
+}
+
+func TestPagerAllReturnsNoResultsAsEmpty(t *testing.T) {
+	p := &pages{pages: map[string]*Page[int]{
+		"/1": {},
+	}}
+
+	got, err := NewPager("/1", p.fetch).All(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got == nil || len(got) != 0 {
+		t.Errorf("got %#v, want an empty slice", got)
+	}
+}
+
+func TestPagerDetectsLoops(t *testing.T) {
+	p := &pages{pages: map[string]*Page[int]{
+		"/1": {Results: []int{1}, Next: "/2"},
+		"/2": {Results: []int{2}, Next: "/1"},
+	}}
+
+	got, err := NewPager("/1", p.fetch).All(context.Background())
+	if !errors.Is(err, ErrPaginationLoop) {
+		t.Errorf("got %v, %v, want %v", got, err, ErrPaginationLoop)
+	}
+	if want := []string{"/1", "/2"}; !reflect.DeepEqual(p.fetched, want) {
+		t.Errorf("fetched %v, want %v", p.fetched, want)
+	}
+}
+
+func TestPagerWithMaxItems(t *testing.T) {
+	tests := []struct {
+		name     string
+		maxItems int
+		want     []int
+		fetched  []string
+	}{
+		{name: "within a page", maxItems: 1, want: []int{1}, fetched: []string{"/1"}},
+		{name: "at the end of a page", maxItems: 2, want: []int{1, 2}, fetched: []string{"/1"}},
+		{name: "across pages", maxItems: 3, want: []int{1, 2, 3}, fetched: []string{"/1", "/2"}},
+		{name: "beyond the results", maxItems: 10, want: []int{1, 2, 3, 4}, fetched: []string{"/1", "/2"}},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := &pages{pages: map[string]*Page[int]{
+				"/1": {Results: []int{1, 2}, Next: "/2"},
+				"/2": {Results: []int{3, 4}},
+			}}
+
+			got, err := NewPager("/1", p.fetch, WithMaxItems(test.maxItems)).All(context.Background())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(got, test.want) {
+				t.Errorf("got %v, want %v", got, test.want)
+			}
+			if !reflect.DeepEqual(p.fetched, test.fetched) {
+				t.Errorf("fetched %v, want %v", p.fetched, test.fetched)
+			}
+		})
+	}
+}
+
+func TestPagerStopsWhenCancelledBetweenPages(t *testing.T) {
+	p := &pages{pages: map[string]*Page[int]{
+		"/1": {Results: []int{1}, Next: "/2"},
+		"/2": {Results: []int{2}},
+	}}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	pager := NewPager("/1", p.fetch)
+	if !pager.Next(ctx) {
+		t.Fatalf("got no first page: %v", pager.Err())
+	}
+	cancel()
+	if pager.Next(ctx) {
+		t.Errorf("got another page after cancelling")
+	}
+	if err := pager.Err(); !errors.Is(err, context.Canceled) {
+		t.Errorf("got %v, want %v", err, context.Canceled)
+	}
+	if want := []string{"/1"}; !reflect.DeepEqual(p.fetched, want) {
+		t.Errorf("fetched %v, want %v", p.fetched, want)
+	}
+}
+
+func TestPagerErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		pages map[string]*Page[int]
+		want  error
+	}{
+		{name: "missing page", pages: map[string]*Page[int]{"/1": {Results: []int{1}, Next: "/2"}}},
+		{name: "empty page", pages: map[string]*Page[int]{"/1": {Results: []int{1}, Next: "/2"}, "/2": nil}, want: ErrEmptyPage},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			p := &pages{pages: test.pages}
+
+			got, err := NewPager("/1", p.fetch).All(context.Background())
+			if err == nil || got != nil {
+				t.Fatalf("got %v, %v, want an error", got, err)
+			}
+			if test.want != nil && !errors.Is(err, test.want) {
+				t.Errorf("got %v, want %v", err, test.want)
+			}
+		})
+	}
+}
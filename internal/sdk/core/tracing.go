@@ -0,0 +1,69 @@
+package core
+
+import (
+	"fmt"
+	"net/http"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the name of the OpenTelemetry tracer API calls are traced
+// with.
+const TracerName = "terraform-provider-vellum/internal/sdk"
+
+// requestIdAttribute holds the ID Vellum assigned to a request.
+const requestIdAttribute = attribute.Key("vellum.request_id")
+
+// tracingHTTPClient starts a client span for every request it issues, as a
+// child of the span in the request's context, if any.
+type tracingHTTPClient struct {
+	client HTTPClient
+	tracer trace.Tracer
+}
+
+func newTracingHTTPClient(client HTTPClient, tracerProvider trace.TracerProvider) HTTPClient {
+	if tracerProvider == nil {
+		tracerProvider = otel.GetTracerProvider()
+	}
+	return &tracingHTTPClient{
+		client: client,
+		tracer: tracerProvider.Tracer(TracerName),
+	}
+}
+
+func (t *tracingHTTPClient) Do(req *http.Request) (*http.Response, error) {
+	ctx, span := t.tracer.Start(
+		req.Context(),
+		req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.HTTPRequestMethodKey.String(req.Method),
+			semconv.URLFull(req.URL.String()),
+			semconv.ServerAddress(req.URL.Hostname()),
+			// The Caller doesn't retry yet, so every request is its first
+			// attempt.
+			semconv.HTTPRequestResendCount(0),
+		),
+	)
+	defer span.End()
+
+	resp, err := t.client.Do(req.WithContext(ctx))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		return resp, err
+	}
+
+	span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+	if requestId := resp.Header.Get(requestIdHeader); requestId != "" {
+		span.SetAttributes(requestIdAttribute.String(requestId))
+	}
+	if resp.StatusCode >= 400 {
+		span.SetStatus(codes.Error, fmt.Sprintf("%d %s", resp.StatusCode, http.StatusText(resp.StatusCode)))
+	}
+	return resp, nil
+}
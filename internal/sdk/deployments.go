@@ -0,0 +1,81 @@
+package api
+
+import (
+	fmt "fmt"
+)
+
+type DeploymentCreateRequest struct {
+	// A human-readable label for the deployment
+	Label string `json:"label"`
+	// A name that uniquely identifies this deployment within its workspace
+	Name string `json:"name"`
+	// A human-readable description of the deployment
+	Description *string `json:"description,omitempty"`
+	// The current status of the deployment
+	//
+	// * `ACTIVE` - Active
+	// * `ARCHIVED` - Archived
+	Status *EntityStatus `json:"status,omitempty"`
+	// The environment this deployment is used in
+	//
+	// * `DEVELOPMENT` - Development
+	// * `STAGING` - Staging
+	// * `PRODUCTION` - Production
+	Environment *EnvironmentEnum `json:"environment,omitempty"`
+}
+
+type DeploymentsListRequest struct {
+	// Number of results to return per page.
+	Limit *int `json:"-"`
+	// The initial index from which to return the results.
+	Offset *int `json:"-"`
+	// Which field to use when ordering the results.
+	Ordering *string `json:"-"`
+	// status
+	Status *DeploymentsListRequestStatus `json:"-"`
+}
+
+type PatchedDeploymentUpdateRequest struct {
+	// A human-readable label for the deployment
+	Label *string `json:"label,omitempty"`
+	// A human-readable description of the deployment
+	Description *string `json:"description,omitempty"`
+	// The current status of the deployment
+	//
+	// * `ACTIVE` - Active
+	// * `ARCHIVED` - Archived
+	Status *EntityStatus `json:"status,omitempty"`
+	// The environment this deployment is used in
+	//
+	// * `DEVELOPMENT` - Development
+	// * `STAGING` - Staging
+	// * `PRODUCTION` - Production
+	Environment *EnvironmentEnum `json:"environment,omitempty"`
+}
+
+type DeploymentsListRequestStatus string
+
+const (
+	DeploymentsListRequestStatusActive   DeploymentsListRequestStatus = "ACTIVE"
+	DeploymentsListRequestStatusArchived DeploymentsListRequestStatus = "ARCHIVED"
+)
+
+func NewDeploymentsListRequestStatusFromString(s string) (DeploymentsListRequestStatus, error) {
+	switch s {
+	case "ACTIVE":
+		return DeploymentsListRequestStatusActive, nil
+	case "ARCHIVED":
+		return DeploymentsListRequestStatusArchived, nil
+	}
+	var t DeploymentsListRequestStatus
+	return "", fmt.Errorf("%s is not a valid %T", s, t)
+}
+
+func (d DeploymentsListRequestStatus) Ptr() *DeploymentsListRequestStatus {
+	return &d
+}
+
+type PatchedDeploymentReleaseTagUpdateRequest struct {
+	// The ID of the Deployment History Item to tag
+	HistoryItemId *string `json:"history_item_id,omitempty"`
+}
@@ -0,0 +1,260 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package deployments
+
+import (
+	context "context"
+	fmt "fmt"
+	http "net/http"
+	url "net/url"
+	vellumclientgo "terraform-provider-vellum/internal/sdk"
+	core "terraform-provider-vellum/internal/sdk/core"
+)
+
+type Client struct {
+	environment *core.Environment
+	caller      *core.Caller
+	header      http.Header
+}
+
+func NewClient(opts ...core.ClientOption) *Client {
+	options := core.NewClientOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+	environment, err := options.ResolveEnvironment()
+	return &Client{
+		environment: environment,
+		caller: core.NewCaller(
+			&core.CallerParams{
+				Client:         options.HTTPClient,
+				ReadOnly:       options.ReadOnly,
+				Logging:        options.Logging,
+				TracerProvider: options.TracerProvider,
+				Cache:          options.Cache,
+				Err:            err,
+			},
+		),
+		header: options.ToHeader(),
+	}
+}
+
+// Used to list all Prompt Deployments.
+func (c *Client) List(ctx context.Context, request *vellumclientgo.DeploymentsListRequest) (*vellumclientgo.PaginatedSlimDeploymentReadList, error) {
+	return c.list(ctx, c.listURL(request))
+}
+
+// Pages returns a *core.Pager that walks every page of results, starting
+// from the given request.
+func (c *Client) Pages(request *vellumclientgo.DeploymentsListRequest, opts ...core.PageOption) *core.Pager[*vellumclientgo.SlimDeploymentRead] {
+	return core.NewPager(
+		c.listURL(request),
+		func(ctx context.Context, url string) (*core.Page[*vellumclientgo.SlimDeploymentRead], error) {
+			response, err := c.list(ctx, url)
+			if err != nil {
+				return nil, err
+			}
+			if response == nil {
+				return nil, fmt.Errorf("%w: %s", core.ErrEmptyPage, url)
+			}
+			page := &core.Page[*vellumclientgo.SlimDeploymentRead]{Results: response.Results}
+			if response.Next != nil {
+				page.Next = *response.Next
+			}
+			return page, nil
+		},
+		opts...,
+	)
+}
+
+// ListAll returns the results of every page, starting from the given request.
+func (c *Client) ListAll(ctx context.Context, request *vellumclientgo.DeploymentsListRequest, opts ...core.PageOption) ([]*vellumclientgo.SlimDeploymentRead, error) {
+	return c.Pages(request, opts...).All(ctx)
+}
+
+func (c *Client) listURL(request *vellumclientgo.DeploymentsListRequest) string {
+	endpointURL := c.environment.Default + "/" + "v1/deployments"
+
+	queryParams := make(url.Values)
+	if request.Limit != nil {
+		queryParams.Add("limit", fmt.Sprintf("%v", *request.Limit))
+	}
+	if request.Offset != nil {
+		queryParams.Add("offset", fmt.Sprintf("%v", *request.Offset))
+	}
+	if request.Ordering != nil {
+		queryParams.Add("ordering", fmt.Sprintf("%v", *request.Ordering))
+	}
+	if request.Status != nil {
+		queryParams.Add("status", fmt.Sprintf("%v", *request.Status))
+	}
+	if len(queryParams) > 0 {
+		endpointURL += "?" + queryParams.Encode()
+	}
+	return endpointURL
+}
+
+func (c *Client) list(ctx context.Context, endpointURL string) (*vellumclientgo.PaginatedSlimDeploymentReadList, error) {
+	var response *vellumclientgo.PaginatedSlimDeploymentReadList
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodGet,
+			Headers:  c.header,
+			Response: &response,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Creates a new Prompt Deployment.
+func (c *Client) Create(ctx context.Context, request *vellumclientgo.DeploymentCreateRequest) (*vellumclientgo.DeploymentRead, error) {
+	endpointURL := c.environment.Default + "/" + "v1/deployments"
+
+	var response *vellumclientgo.DeploymentRead
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodPost,
+			Headers:  c.header,
+			Request:  request,
+			Response: &response,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Used to retrieve a Prompt Deployment given its ID or name.
+//
+// Either the Prompt Deployment's ID or its unique name
+func (c *Client) Retrieve(ctx context.Context, id string) (*vellumclientgo.DeploymentRead, error) {
+	endpointURL := fmt.Sprintf(c.environment.Default+"/"+"v1/deployments/%v", id)
+
+	var response *vellumclientgo.DeploymentRead
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodGet,
+			Headers:  c.header,
+			Response: &response,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Used to delete a Prompt Deployment given its ID.
+//
+// A UUID string identifying this deployment.
+func (c *Client) Destroy(ctx context.Context, id string) error {
+	endpointURL := fmt.Sprintf(c.environment.Default+"/"+"v1/deployments/%v", id)
+
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:     endpointURL,
+			Method:  http.MethodDelete,
+			Headers: c.header,
+		},
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Used to partial update a Prompt Deployment given its ID.
+//
+// A UUID string identifying this deployment.
+func (c *Client) PartialUpdate(ctx context.Context, id string, request *vellumclientgo.PatchedDeploymentUpdateRequest) (*vellumclientgo.DeploymentRead, error) {
+	endpointURL := fmt.Sprintf(c.environment.Default+"/"+"v1/deployments/%v", id)
+
+	var response *vellumclientgo.DeploymentRead
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodPatch,
+			Headers:  c.header,
+			Request:  request,
+			Response: &response,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Retrieve a specific Deployment History Item by either its UUID or the name of a Release Tag that points to it.
+//
+// A UUID string identifying this deployment.
+// Either the UUID of Deployment History Item you'd like to retrieve, or the name of a Release Tag that's pointing to the Deployment History Item you'd like to retrieve.
+func (c *Client) DeploymentHistoryItemRetrieve(ctx context.Context, historyIdOrReleaseTag string, id string) (*vellumclientgo.DeploymentHistoryItem, error) {
+	endpointURL := fmt.Sprintf(c.environment.Default+"/"+"v1/deployments/%v/history/%v", id, historyIdOrReleaseTag)
+
+	var response *vellumclientgo.DeploymentHistoryItem
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodGet,
+			Headers:  c.header,
+			Response: &response,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Retrieve a Deployment Release Tag by tag name, associated with a specified Deployment.
+//
+// A UUID string identifying this deployment.
+// The name of the Release Tag associated with this Deployment that you'd like to retrieve.
+func (c *Client) RetrieveDeploymentReleaseTag(ctx context.Context, id string, name string) (*vellumclientgo.DeploymentReleaseTagRead, error) {
+	endpointURL := fmt.Sprintf(c.environment.Default+"/"+"v1/deployments/%v/release-tags/%v", id, name)
+
+	var response *vellumclientgo.DeploymentReleaseTagRead
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodGet,
+			Headers:  c.header,
+			Response: &response,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Updates an existing Release Tag associated with the specified Deployment.
+//
+// A UUID string identifying this deployment.
+// The name of the Release Tag associated with this Deployment that you'd like to update.
+func (c *Client) UpdateDeploymentReleaseTag(ctx context.Context, id string, name string, request *vellumclientgo.PatchedDeploymentReleaseTagUpdateRequest) (*vellumclientgo.DeploymentReleaseTagRead, error) {
+	endpointURL := fmt.Sprintf(c.environment.Default+"/"+"v1/deployments/%v/release-tags/%v", id, name)
+
+	var response *vellumclientgo.DeploymentReleaseTagRead
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodPatch,
+			Headers:  c.header,
+			Request:  request,
+			Response: &response,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
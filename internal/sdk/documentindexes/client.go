@@ -12,9 +12,9 @@ import (
 )
 
 type Client struct {
-	baseURL string
-	caller  *core.Caller
-	header  http.Header
+	environment *core.Environment
+	caller      *core.Caller
+	header      http.Header
 }
 
 func NewClient(opts ...core.ClientOption) *Client {
@@ -22,20 +22,58 @@ func NewClient(opts ...core.ClientOption) *Client {
 	for _, opt := range opts {
 		opt(options)
 	}
+	environment, err := options.ResolveEnvironment()
 	return &Client{
-		baseURL: options.BaseURL,
-		caller:  core.NewCaller(options.HTTPClient),
-		header:  options.ToHeader(),
+		environment: environment,
+		caller: core.NewCaller(
+			&core.CallerParams{
+				Client:         options.HTTPClient,
+				ReadOnly:       options.ReadOnly,
+				Logging:        options.Logging,
+				TracerProvider: options.TracerProvider,
+				Cache:          options.Cache,
+				Err:            err,
+			},
+		),
+		header: options.ToHeader(),
 	}
 }
 
 // Used to retrieve a list of Document Indexes.
 func (c *Client) List(ctx context.Context, request *vellumclientgo.DocumentIndexesListRequest) (*vellumclientgo.PaginatedDocumentIndexReadList, error) {
-	baseURL := "https://api.vellum.ai"
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
-	endpointURL := baseURL + "/" + "v1/document-indexes"
+	return c.list(ctx, c.listURL(request))
+}
+
+// Pages returns a *core.Pager that walks every page of results, starting
+// from the given request.
+func (c *Client) Pages(request *vellumclientgo.DocumentIndexesListRequest, opts ...core.PageOption) *core.Pager[*vellumclientgo.DocumentIndexRead] {
+	return core.NewPager(
+		c.listURL(request),
+		func(ctx context.Context, url string) (*core.Page[*vellumclientgo.DocumentIndexRead], error) {
+			response, err := c.list(ctx, url)
+			if err != nil {
+				return nil, err
+			}
+			if response == nil {
+				return nil, fmt.Errorf("%w: %s", core.ErrEmptyPage, url)
+			}
+			page := &core.Page[*vellumclientgo.DocumentIndexRead]{Results: response.Results}
+			if response.Next != nil {
+				page.Next = *response.Next
+			}
+			return page, nil
+		},
+		opts...,
+	)
+}
+
+// ListAll returns the results of every page, starting from the given request.
+func (c *Client) ListAll(ctx context.Context, request *vellumclientgo.DocumentIndexesListRequest, opts ...core.PageOption) ([]*vellumclientgo.DocumentIndexRead, error) {
+	return c.Pages(request, opts...).All(ctx)
+}
+
+func (c *Client) listURL(request *vellumclientgo.DocumentIndexesListRequest) string {
+	endpointURL := c.environment.Default + "/" + "v1/document-indexes"
 
 	queryParams := make(url.Values)
 	if request.Limit != nil {
@@ -53,7 +91,10 @@ func (c *Client) List(ctx context.Context, request *vellumclientgo.DocumentIndex
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
+	return endpointURL
+}
 
+func (c *Client) list(ctx context.Context, endpointURL string) (*vellumclientgo.PaginatedDocumentIndexReadList, error) {
 	var response *vellumclientgo.PaginatedDocumentIndexReadList
 	if err := c.caller.Call(
 		ctx,
@@ -71,11 +112,7 @@ func (c *Client) List(ctx context.Context, request *vellumclientgo.DocumentIndex
 
 // Creates a new document index.
 func (c *Client) Create(ctx context.Context, request *vellumclientgo.DocumentIndexCreateRequest) (*vellumclientgo.DocumentIndexRead, error) {
-	baseURL := "https://api.vellum.ai"
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
-	endpointURL := baseURL + "/" + "v1/document-indexes"
+	endpointURL := c.environment.Default + "/" + "v1/document-indexes"
 
 	var response *vellumclientgo.DocumentIndexRead
 	if err := c.caller.Call(
@@ -97,11 +134,7 @@ func (c *Client) Create(ctx context.Context, request *vellumclientgo.DocumentInd
 //
 // Either the Document Index's ID or its unique name
 func (c *Client) Retrieve(ctx context.Context, id string) (*vellumclientgo.DocumentIndexRead, error) {
-	baseURL := "https://api.vellum.ai"
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
-	endpointURL := fmt.Sprintf(baseURL+"/"+"v1/document-indexes/%v", id)
+	endpointURL := fmt.Sprintf(c.environment.Default+"/"+"v1/document-indexes/%v", id)
 
 	var response *vellumclientgo.DocumentIndexRead
 	if err := c.caller.Call(
@@ -122,11 +155,7 @@ func (c *Client) Retrieve(ctx context.Context, id string) (*vellumclientgo.Docum
 //
 // A UUID string identifying this document index.
 func (c *Client) Update(ctx context.Context, id string, request *vellumclientgo.DocumentIndexUpdateRequest) (*vellumclientgo.DocumentIndexRead, error) {
-	baseURL := "https://api.vellum.ai"
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
-	endpointURL := fmt.Sprintf(baseURL+"/"+"v1/document-indexes/%v", id)
+	endpointURL := fmt.Sprintf(c.environment.Default+"/"+"v1/document-indexes/%v", id)
 
 	var response *vellumclientgo.DocumentIndexRead
 	if err := c.caller.Call(
@@ -148,11 +177,7 @@ func (c *Client) Update(ctx context.Context, id string, request *vellumclientgo.
 //
 // A UUID string identifying this document index.
 func (c *Client) Destroy(ctx context.Context, id string) error {
-	baseURL := "https://api.vellum.ai"
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
-	endpointURL := fmt.Sprintf(baseURL+"/"+"v1/document-indexes/%v", id)
+	endpointURL := fmt.Sprintf(c.environment.Default+"/"+"v1/document-indexes/%v", id)
 
 	if err := c.caller.Call(
 		ctx,
@@ -171,11 +196,7 @@ func (c *Client) Destroy(ctx context.Context, id string) error {
 //
 // A UUID string identifying this document index.
 func (c *Client) PartialUpdate(ctx context.Context, id string, request *vellumclientgo.PatchedDocumentIndexUpdateRequest) (*vellumclientgo.DocumentIndexRead, error) {
-	baseURL := "https://api.vellum.ai"
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
-	endpointURL := fmt.Sprintf(baseURL+"/"+"v1/document-indexes/%v", id)
+	endpointURL := fmt.Sprintf(c.environment.Default+"/"+"v1/document-indexes/%v", id)
 
 	var response *vellumclientgo.DocumentIndexRead
 	if err := c.caller.Call(
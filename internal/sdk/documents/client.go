@@ -0,0 +1,239 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package documents
+
+import (
+	bytes "bytes"
+	context "context"
+	fmt "fmt"
+	io "io"
+	multipart "mime/multipart"
+	http "net/http"
+	url "net/url"
+	vellumclientgo "terraform-provider-vellum/internal/sdk"
+	core "terraform-provider-vellum/internal/sdk/core"
+)
+
+type Client struct {
+	environment *core.Environment
+	caller      *core.Caller
+	header      http.Header
+}
+
+func NewClient(opts ...core.ClientOption) *Client {
+	options := core.NewClientOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+	environment, err := options.ResolveEnvironment()
+	return &Client{
+		environment: environment,
+		caller: core.NewCaller(
+			&core.CallerParams{
+				Client:         options.HTTPClient,
+				ReadOnly:       options.ReadOnly,
+				Logging:        options.Logging,
+				TracerProvider: options.TracerProvider,
+				Cache:          options.Cache,
+				Err:            err,
+			},
+		),
+		header: options.ToHeader(),
+	}
+}
+
+// Used to list documents. Optionally filter on supported fields.
+func (c *Client) List(ctx context.Context, request *vellumclientgo.DocumentsListRequest) (*vellumclientgo.PaginatedSlimDocumentList, error) {
+	return c.list(ctx, c.listURL(request))
+}
+
+// Pages returns a *core.Pager that walks every page of results, starting
+// from the given request.
+func (c *Client) Pages(request *vellumclientgo.DocumentsListRequest, opts ...core.PageOption) *core.Pager[*vellumclientgo.SlimDocument] {
+	return core.NewPager(
+		c.listURL(request),
+		func(ctx context.Context, url string) (*core.Page[*vellumclientgo.SlimDocument], error) {
+			response, err := c.list(ctx, url)
+			if err != nil {
+				return nil, err
+			}
+			if response == nil {
+				return nil, fmt.Errorf("%w: %s", core.ErrEmptyPage, url)
+			}
+			page := &core.Page[*vellumclientgo.SlimDocument]{Results: response.Results}
+			if response.Next != nil {
+				page.Next = *response.Next
+			}
+			return page, nil
+		},
+		opts...,
+	)
+}
+
+// ListAll returns the results of every page, starting from the given request.
+func (c *Client) ListAll(ctx context.Context, request *vellumclientgo.DocumentsListRequest, opts ...core.PageOption) ([]*vellumclientgo.SlimDocument, error) {
+	return c.Pages(request, opts...).All(ctx)
+}
+
+func (c *Client) listURL(request *vellumclientgo.DocumentsListRequest) string {
+	endpointURL := c.environment.Default + "/" + "v1/documents"
+
+	queryParams := make(url.Values)
+	if request.DocumentIndexId != nil {
+		queryParams.Add("document_index_id", fmt.Sprintf("%v", *request.DocumentIndexId))
+	}
+	if request.Limit != nil {
+		queryParams.Add("limit", fmt.Sprintf("%v", *request.Limit))
+	}
+	if request.Offset != nil {
+		queryParams.Add("offset", fmt.Sprintf("%v", *request.Offset))
+	}
+	if request.Ordering != nil {
+		queryParams.Add("ordering", fmt.Sprintf("%v", *request.Ordering))
+	}
+	if len(queryParams) > 0 {
+		endpointURL += "?" + queryParams.Encode()
+	}
+	return endpointURL
+}
+
+func (c *Client) list(ctx context.Context, endpointURL string) (*vellumclientgo.PaginatedSlimDocumentList, error) {
+	var response *vellumclientgo.PaginatedSlimDocumentList
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodGet,
+			Headers:  c.header,
+			Response: &response,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Retrieve a Document, keying off of either its Vellum-generated ID or its external ID.
+//
+// A UUID string identifying this document.
+func (c *Client) Retrieve(ctx context.Context, id string) (*vellumclientgo.DocumentRead, error) {
+	endpointURL := fmt.Sprintf(c.environment.Default+"/"+"v1/documents/%v", id)
+
+	var response *vellumclientgo.DocumentRead
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodGet,
+			Headers:  c.header,
+			Response: &response,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Delete a Document, keying off of either its Vellum-generated ID or its external ID.
+//
+// A UUID string identifying this document.
+func (c *Client) Destroy(ctx context.Context, id string) error {
+	endpointURL := fmt.Sprintf(c.environment.Default+"/"+"v1/documents/%v", id)
+
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:     endpointURL,
+			Method:  http.MethodDelete,
+			Headers: c.header,
+		},
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Update a Document, keying off of either its Vellum-generated ID or its external ID. Particularly useful for updating its metadata.
+//
+// A UUID string identifying this document.
+func (c *Client) PartialUpdate(ctx context.Context, id string, request *vellumclientgo.PatchedDocumentUpdateRequest) (*vellumclientgo.DocumentRead, error) {
+	endpointURL := fmt.Sprintf(c.environment.Default+"/"+"v1/documents/%v", id)
+
+	var response *vellumclientgo.DocumentRead
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodPatch,
+			Headers:  c.header,
+			Request:  request,
+			Response: &response,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Upload a document to be indexed and used for search.
+//
+// The contents are sent as the `contents` part of a multipart form, named
+// after the given filename so Vellum can infer the file type.
+func (c *Client) Upload(ctx context.Context, contents io.Reader, filename string, request *vellumclientgo.UploadDocumentBodyRequest) (*vellumclientgo.UploadDocumentResponse, error) {
+	endpointURL := c.environment.Documents + "/" + "v1/upload-document"
+
+	requestBuffer := bytes.NewBuffer(nil)
+	writer := multipart.NewWriter(requestBuffer)
+	contentsPart, err := writer.CreateFormFile("contents", filename)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := io.Copy(contentsPart, contents); err != nil {
+		return nil, err
+	}
+	if request.AddToIndexNames != nil {
+		if err := core.WriteMultipartJSON(writer, "add_to_index_names", request.AddToIndexNames); err != nil {
+			return nil, err
+		}
+	}
+	if request.ExternalId != nil {
+		if err := writer.WriteField("external_id", fmt.Sprintf("%v", *request.ExternalId)); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.WriteField("label", fmt.Sprintf("%v", request.Label)); err != nil {
+		return nil, err
+	}
+	if request.Keywords != nil {
+		if err := core.WriteMultipartJSON(writer, "keywords", request.Keywords); err != nil {
+			return nil, err
+		}
+	}
+	if request.Metadata != nil {
+		if err := core.WriteMultipartJSON(writer, "metadata", request.Metadata); err != nil {
+			return nil, err
+		}
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+
+	// Clone the headers so concurrent uploads don't race on the Content-Type.
+	headers := c.header.Clone()
+	headers.Set("Content-Type", writer.FormDataContentType())
+
+	var response *vellumclientgo.UploadDocumentResponse
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodPost,
+			Headers:  headers,
+			Request:  requestBuffer,
+			Response: &response,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
@@ -0,0 +1,19 @@
+package api
+
+import (
+	core "terraform-provider-vellum/internal/sdk/core"
+)
+
+// Environment is a deployment of the Vellum API.
+type Environment = core.Environment
+
+// Environments defines all of the API environments.
+// These values can be used with the WithEnvironment
+// ClientOption to override the client's default environment.
+// Use core.NewEnvironment for a custom environment, such as
+// a self-hosted Vellum.
+var Environments = struct {
+	Production *Environment
+}{
+	Production: core.ProductionEnvironment,
+}
@@ -0,0 +1,69 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package folderentities
+
+import (
+	context "context"
+	fmt "fmt"
+	http "net/http"
+	vellumclientgo "terraform-provider-vellum/internal/sdk"
+	core "terraform-provider-vellum/internal/sdk/core"
+)
+
+type Client struct {
+	environment *core.Environment
+	caller      *core.Caller
+	header      http.Header
+}
+
+func NewClient(opts ...core.ClientOption) *Client {
+	options := core.NewClientOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+	environment, err := options.ResolveEnvironment()
+	return &Client{
+		environment: environment,
+		caller: core.NewCaller(
+			&core.CallerParams{
+				Client:         options.HTTPClient,
+				ReadOnly:       options.ReadOnly,
+				Logging:        options.Logging,
+				TracerProvider: options.TracerProvider,
+				Cache:          options.Cache,
+				Err:            err,
+			},
+		),
+		header: options.ToHeader(),
+	}
+}
+
+// Add an entity to a specific folder or root directory.
+//
+// Adding an entity to a folder will remove it from any other folders it might have been a member of.
+//
+// The ID of the folder to which the entity should be added. This can be a UUID of a folder, or the name of a root
+// directory. Supported root directories include:
+//
+// - PROMPT_SANDBOX
+// - WORKFLOW_SANDBOX
+// - DOCUMENT_INDEX
+// - TEST_SUITE
+// - DEPLOYMENT
+// - ML_MODEL
+func (c *Client) AddEntityToFolder(ctx context.Context, folderId string, request *vellumclientgo.AddEntityToFolderRequest) error {
+	endpointURL := fmt.Sprintf(c.environment.Default+"/"+"v1/folder-entities/%v/add-entity", folderId)
+
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:     endpointURL,
+			Method:  http.MethodPost,
+			Headers: c.header,
+			Request: request,
+		},
+	); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,30 @@
+package api
+
+type FolderCreateRequest struct {
+	// The folder's name
+	Name string `json:"name"`
+	// The ID of the folder to nest this folder in. Top-level folders have none.
+	ParentFolderId *string `json:"parent_folder_id,omitempty"`
+}
+
+type PatchedFolderUpdateRequest struct {
+	// The folder's name
+	Name *string `json:"name,omitempty"`
+	// The ID of the folder to nest this folder in. Always sent, so that null
+	// moves the folder to the top level.
+	ParentFolderId *string `json:"parent_folder_id"`
+}
+
+type FoldersListRequest struct {
+	// Number of results to return per page.
+	Limit *int `json:"-"`
+	// The initial index from which to return the results.
+	Offset *int `json:"-"`
+	// Only return folders nested directly in this folder
+	ParentFolderId *string `json:"-"`
+}
+
+type AddEntityToFolderRequest struct {
+	// The ID of the entity you would like to move.
+	EntityId string `json:"entity_id"`
+}
@@ -0,0 +1,190 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package folders
+
+import (
+	context "context"
+	fmt "fmt"
+	http "net/http"
+	url "net/url"
+	vellumclientgo "terraform-provider-vellum/internal/sdk"
+	core "terraform-provider-vellum/internal/sdk/core"
+)
+
+type Client struct {
+	environment *core.Environment
+	caller      *core.Caller
+	header      http.Header
+}
+
+func NewClient(opts ...core.ClientOption) *Client {
+	options := core.NewClientOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+	environment, err := options.ResolveEnvironment()
+	return &Client{
+		environment: environment,
+		caller: core.NewCaller(
+			&core.CallerParams{
+				Client:         options.HTTPClient,
+				ReadOnly:       options.ReadOnly,
+				Logging:        options.Logging,
+				TracerProvider: options.TracerProvider,
+				Cache:          options.Cache,
+				Err:            err,
+			},
+		),
+		header: options.ToHeader(),
+	}
+}
+
+// Used to list Folders.
+func (c *Client) List(ctx context.Context, request *vellumclientgo.FoldersListRequest) (*vellumclientgo.PaginatedFolderReadList, error) {
+	return c.list(ctx, c.listURL(request))
+}
+
+// Pages returns a *core.Pager that walks every page of results, starting
+// from the given request.
+func (c *Client) Pages(request *vellumclientgo.FoldersListRequest, opts ...core.PageOption) *core.Pager[*vellumclientgo.FolderRead] {
+	return core.NewPager(
+		c.listURL(request),
+		func(ctx context.Context, url string) (*core.Page[*vellumclientgo.FolderRead], error) {
+			response, err := c.list(ctx, url)
+			if err != nil {
+				return nil, err
+			}
+			if response == nil {
+				return nil, fmt.Errorf("%w: %s", core.ErrEmptyPage, url)
+			}
+			page := &core.Page[*vellumclientgo.FolderRead]{Results: response.Results}
+			if response.Next != nil {
+				page.Next = *response.Next
+			}
+			return page, nil
+		},
+		opts...,
+	)
+}
+
+// ListAll returns the results of every page, starting from the given request.
+func (c *Client) ListAll(ctx context.Context, request *vellumclientgo.FoldersListRequest, opts ...core.PageOption) ([]*vellumclientgo.FolderRead, error) {
+	return c.Pages(request, opts...).All(ctx)
+}
+
+func (c *Client) listURL(request *vellumclientgo.FoldersListRequest) string {
+	endpointURL := c.environment.Default + "/" + "v1/folders"
+
+	queryParams := make(url.Values)
+	if request.Limit != nil {
+		queryParams.Add("limit", fmt.Sprintf("%v", *request.Limit))
+	}
+	if request.Offset != nil {
+		queryParams.Add("offset", fmt.Sprintf("%v", *request.Offset))
+	}
+	if request.ParentFolderId != nil {
+		queryParams.Add("parent_folder_id", fmt.Sprintf("%v", *request.ParentFolderId))
+	}
+	if len(queryParams) > 0 {
+		endpointURL += "?" + queryParams.Encode()
+	}
+	return endpointURL
+}
+
+func (c *Client) list(ctx context.Context, endpointURL string) (*vellumclientgo.PaginatedFolderReadList, error) {
+	var response *vellumclientgo.PaginatedFolderReadList
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodGet,
+			Headers:  c.header,
+			Response: &response,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Used to create a new Folder.
+func (c *Client) Create(ctx context.Context, request *vellumclientgo.FolderCreateRequest) (*vellumclientgo.FolderRead, error) {
+	endpointURL := c.environment.Default + "/" + "v1/folders"
+
+	var response *vellumclientgo.FolderRead
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodPost,
+			Headers:  c.header,
+			Request:  request,
+			Response: &response,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Used to retrieve a Folder given its ID.
+//
+// A UUID string identifying this folder.
+func (c *Client) Retrieve(ctx context.Context, id string) (*vellumclientgo.FolderRead, error) {
+	endpointURL := fmt.Sprintf(c.environment.Default+"/"+"v1/folders/%v", id)
+
+	var response *vellumclientgo.FolderRead
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodGet,
+			Headers:  c.header,
+			Response: &response,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Used to rename a Folder or move it to another parent Folder, given its ID.
+//
+// A UUID string identifying this folder.
+func (c *Client) PartialUpdate(ctx context.Context, id string, request *vellumclientgo.PatchedFolderUpdateRequest) (*vellumclientgo.FolderRead, error) {
+	endpointURL := fmt.Sprintf(c.environment.Default+"/"+"v1/folders/%v", id)
+
+	var response *vellumclientgo.FolderRead
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodPatch,
+			Headers:  c.header,
+			Request:  request,
+			Response: &response,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Used to delete a Folder given its ID.
+//
+// A UUID string identifying this folder.
+func (c *Client) Destroy(ctx context.Context, id string) error {
+	endpointURL := fmt.Sprintf(c.environment.Default+"/"+"v1/folders/%v", id)
+
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:     endpointURL,
+			Method:  http.MethodDelete,
+			Headers: c.header,
+		},
+	); err != nil {
+		return err
+	}
+	return nil
+}
@@ -12,9 +12,9 @@ import (
 )
 
 type Client struct {
-	baseURL string
-	caller  *core.Caller
-	header  http.Header
+	environment *core.Environment
+	caller      *core.Caller
+	header      http.Header
 }
 
 func NewClient(opts ...core.ClientOption) *Client {
@@ -22,20 +22,58 @@ func NewClient(opts ...core.ClientOption) *Client {
 	for _, opt := range opts {
 		opt(options)
 	}
+	environment, err := options.ResolveEnvironment()
 	return &Client{
-		baseURL: options.BaseURL,
-		caller:  core.NewCaller(options.HTTPClient),
-		header:  options.ToHeader(),
+		environment: environment,
+		caller: core.NewCaller(
+			&core.CallerParams{
+				Client:         options.HTTPClient,
+				ReadOnly:       options.ReadOnly,
+				Logging:        options.Logging,
+				TracerProvider: options.TracerProvider,
+				Cache:          options.Cache,
+				Err:            err,
+			},
+		),
+		header: options.ToHeader(),
 	}
 }
 
 // List all ML Models that your Workspace has access to.
 func (c *Client) List(ctx context.Context, request *vellumclientgo.MlModelsListRequest) (*vellumclientgo.PaginatedMlModelReadList, error) {
-	baseURL := "https://api.vellum.ai"
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
-	endpointURL := baseURL + "/" + "v1/ml-models"
+	return c.list(ctx, c.listURL(request))
+}
+
+// Pages returns a *core.Pager that walks every page of results, starting
+// from the given request.
+func (c *Client) Pages(request *vellumclientgo.MlModelsListRequest, opts ...core.PageOption) *core.Pager[*vellumclientgo.MlModelRead] {
+	return core.NewPager(
+		c.listURL(request),
+		func(ctx context.Context, url string) (*core.Page[*vellumclientgo.MlModelRead], error) {
+			response, err := c.list(ctx, url)
+			if err != nil {
+				return nil, err
+			}
+			if response == nil {
+				return nil, fmt.Errorf("%w: %s", core.ErrEmptyPage, url)
+			}
+			page := &core.Page[*vellumclientgo.MlModelRead]{Results: response.Results}
+			if response.Next != nil {
+				page.Next = *response.Next
+			}
+			return page, nil
+		},
+		opts...,
+	)
+}
+
+// ListAll returns the results of every page, starting from the given request.
+func (c *Client) ListAll(ctx context.Context, request *vellumclientgo.MlModelsListRequest, opts ...core.PageOption) ([]*vellumclientgo.MlModelRead, error) {
+	return c.Pages(request, opts...).All(ctx)
+}
+
+func (c *Client) listURL(request *vellumclientgo.MlModelsListRequest) string {
+	endpointURL := c.environment.Default + "/" + "v1/ml-models"
 
 	queryParams := make(url.Values)
 	if request.Limit != nil {
@@ -50,7 +88,10 @@ func (c *Client) List(ctx context.Context, request *vellumclientgo.MlModelsListR
 	if len(queryParams) > 0 {
 		endpointURL += "?" + queryParams.Encode()
 	}
+	return endpointURL
+}
 
+func (c *Client) list(ctx context.Context, endpointURL string) (*vellumclientgo.PaginatedMlModelReadList, error) {
 	var response *vellumclientgo.PaginatedMlModelReadList
 	if err := c.caller.Call(
 		ctx,
@@ -68,11 +109,7 @@ func (c *Client) List(ctx context.Context, request *vellumclientgo.MlModelsListR
 
 // Creates a new ML Model.
 func (c *Client) Create(ctx context.Context, request *vellumclientgo.MlModelCreateRequest) (*vellumclientgo.MlModelRead, error) {
-	baseURL := "https://api.vellum.ai"
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
-	endpointURL := baseURL + "/" + "v1/ml-models"
+	endpointURL := c.environment.Default + "/" + "v1/ml-models"
 
 	var response *vellumclientgo.MlModelRead
 	if err := c.caller.Call(
@@ -94,11 +131,7 @@ func (c *Client) Create(ctx context.Context, request *vellumclientgo.MlModelCrea
 //
 // A UUID string identifying this ml model.
 func (c *Client) Retrieve(ctx context.Context, id string) (*vellumclientgo.MlModelRead, error) {
-	baseURL := "https://api.vellum.ai"
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
-	endpointURL := fmt.Sprintf(baseURL+"/"+"v1/ml-models/%v", id)
+	endpointURL := fmt.Sprintf(c.environment.Default+"/"+"v1/ml-models/%v", id)
 
 	var response *vellumclientgo.MlModelRead
 	if err := c.caller.Call(
@@ -119,11 +152,7 @@ func (c *Client) Retrieve(ctx context.Context, id string) (*vellumclientgo.MlMod
 //
 // A UUID string identifying this ml model.
 func (c *Client) Update(ctx context.Context, id string, request *vellumclientgo.MlModelUpdateRequest) (*vellumclientgo.MlModelRead, error) {
-	baseURL := "https://api.vellum.ai"
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
-	endpointURL := fmt.Sprintf(baseURL+"/"+"v1/ml-models/%v", id)
+	endpointURL := fmt.Sprintf(c.environment.Default+"/"+"v1/ml-models/%v", id)
 
 	var response *vellumclientgo.MlModelRead
 	if err := c.caller.Call(
@@ -145,11 +174,7 @@ func (c *Client) Update(ctx context.Context, id string, request *vellumclientgo.
 //
 // A UUID string identifying this ml model.
 func (c *Client) PartialUpdate(ctx context.Context, id string, request *vellumclientgo.PatchedMlModelUpdateRequest) (*vellumclientgo.MlModelRead, error) {
-	baseURL := "https://api.vellum.ai"
-	if c.baseURL != "" {
-		baseURL = c.baseURL
-	}
-	endpointURL := fmt.Sprintf(baseURL+"/"+"v1/ml-models/%v", id)
+	endpointURL := fmt.Sprintf(c.environment.Default+"/"+"v1/ml-models/%v", id)
 
 	var response *vellumclientgo.MlModelRead
 	if err := c.caller.Call(
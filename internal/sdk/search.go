@@ -0,0 +1,62 @@
+package api
+
+type SearchRequestBodyRequest struct {
+	// The ID of the index to search against. Must provide either this or index_name.
+	IndexId *string `json:"index_id,omitempty"`
+	// The name of the index to search against. Must provide either this or index_id.
+	IndexName *string `json:"index_name,omitempty"`
+	// The query to search for.
+	Query string `json:"query"`
+	// Configuration options for the search.
+	Options *SearchRequestOptionsRequest `json:"options,omitempty"`
+}
+
+type SearchRequestOptionsRequest struct {
+	// The maximum number of results to return.
+	Limit *int `json:"limit,omitempty"`
+	// The weights to use for the search. Must add up to 1.0.
+	Weights *SearchWeightsRequest `json:"weights,omitempty"`
+	// The configuration for merging results.
+	ResultMerging *SearchResultMergingRequest `json:"result_merging,omitempty"`
+	// The filters to apply to the search.
+	Filters *SearchFiltersRequest `json:"filters,omitempty"`
+}
+
+type SearchWeightsRequest struct {
+	// The relative weight to give to semantic similarity
+	SemanticSimilarity *float64 `json:"semantic_similarity,omitempty"`
+	// The relative weight to give to keywords
+	Keywords *float64 `json:"keywords,omitempty"`
+}
+
+type SearchResultMergingRequest struct {
+	// Whether to enable merging results
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+type SearchFiltersRequest struct {
+	// The document external IDs to filter by
+	ExternalIds []string `json:"external_ids,omitempty"`
+	// The metadata filters to apply to the search
+	Metadata *MetadataFilterConfigRequest `json:"metadata,omitempty"`
+}
+
+// A group of metadata filter rules, or a single rule when Field, Operator
+// and Value are set.
+type MetadataFilterConfigRequest struct {
+	Combinator *MetadataFilterRuleCombinator `json:"combinator,omitempty"`
+	Negated    *bool                         `json:"negated,omitempty"`
+	Rules      []*MetadataFilterRuleRequest  `json:"rules,omitempty"`
+	Field      *string                       `json:"field,omitempty"`
+	Operator   *LogicalOperator              `json:"operator,omitempty"`
+	Value      *string                       `json:"value,omitempty"`
+}
+
+type MetadataFilterRuleRequest struct {
+	Combinator *MetadataFilterRuleCombinator `json:"combinator,omitempty"`
+	Negated    *bool                         `json:"negated,omitempty"`
+	Rules      []*MetadataFilterRuleRequest  `json:"rules,omitempty"`
+	Field      *string                       `json:"field,omitempty"`
+	Operator   *LogicalOperator              `json:"operator,omitempty"`
+	Value      *string                       `json:"value,omitempty"`
+}
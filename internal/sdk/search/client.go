@@ -0,0 +1,59 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package search
+
+import (
+	context "context"
+	http "net/http"
+	vellumclientgo "terraform-provider-vellum/internal/sdk"
+	core "terraform-provider-vellum/internal/sdk/core"
+)
+
+type Client struct {
+	environment *core.Environment
+	caller      *core.Caller
+	header      http.Header
+}
+
+func NewClient(opts ...core.ClientOption) *Client {
+	options := core.NewClientOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+	environment, err := options.ResolveEnvironment()
+	return &Client{
+		environment: environment,
+		caller: core.NewCaller(
+			&core.CallerParams{
+				Client:         options.HTTPClient,
+				ReadOnly:       options.ReadOnly,
+				Logging:        options.Logging,
+				TracerProvider: options.TracerProvider,
+				Cache:          options.Cache,
+				Err:            err,
+			},
+		),
+		header: options.ToHeader(),
+	}
+}
+
+// Perform a search against a document index.
+func (c *Client) Search(ctx context.Context, request *vellumclientgo.SearchRequestBodyRequest) (*vellumclientgo.SearchResponse, error) {
+	endpointURL := c.environment.Predict + "/" + "v1/search"
+
+	var response *vellumclientgo.SearchResponse
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodPost,
+			Headers:  c.header,
+			Request:  request,
+			Response: &response,
+			Safe:     true,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
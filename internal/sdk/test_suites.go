@@ -0,0 +1,97 @@
+package api
+
+import (
+	fmt "fmt"
+)
+
+type TestSuiteCreateRequest struct {
+	// A name that uniquely identifies this test suite within its workspace
+	Name string `json:"name"`
+	// A human-readable label for the test suite
+	Label *string `json:"label,omitempty"`
+	// The variables every test case provides input values for
+	InputVariables []*TestSuiteVariableRequest `json:"input_variables,omitempty"`
+	// The variables every test case provides expected output values for
+	EvaluationVariables []*TestSuiteVariableRequest `json:"evaluation_variables,omitempty"`
+}
+
+type PatchedTestSuiteUpdateRequest struct {
+	// A human-readable label for the test suite
+	Label *string `json:"label,omitempty"`
+	// The variables every test case provides input values for
+	InputVariables []*TestSuiteVariableRequest `json:"input_variables,omitempty"`
+	// The variables every test case provides expected output values for
+	EvaluationVariables []*TestSuiteVariableRequest `json:"evaluation_variables,omitempty"`
+}
+
+type TestSuiteVariableRequest struct {
+	Key      string             `json:"key"`
+	Type     VellumVariableType `json:"type"`
+	Required *bool              `json:"required,omitempty"`
+}
+
+type TestSuitesListTestSuiteTestCasesRequest struct {
+	// Number of results to return per page.
+	Limit *int `json:"-"`
+	// The initial index from which to return the results.
+	Offset *int `json:"-"`
+}
+
+type TestSuiteTestCaseRequest struct {
+	// The Vellum-generated ID of an existing Test Case whose data you'd like to replace. If specified and no Test Case exists with this ID, a 404 will be returned.
+	Id *string `json:"id,omitempty"`
+	// An ID external to Vellum that uniquely identifies the Test Case that you'd like to create/update. If there's a match on a Test Case that was previously created with the same external_id, it will be updated. Otherwise, a new Test Case will be created with this value as its external_id.
+	ExternalId *string `json:"external_id,omitempty"`
+	// A human-readable label used to convey the intention of this Test Case
+	Label *string `json:"label,omitempty"`
+	// Values for each of the Test Case's input variables
+	InputValues []*NamedTestCaseVariableValueRequest `json:"input_values,omitempty"`
+	// Values for each of the Test Case's evaluation variables
+	EvaluationValues []*NamedTestCaseVariableValueRequest `json:"evaluation_values,omitempty"`
+}
+
+// A named Test Case variable value. Value must match Type: a string for
+// `STRING`, a number for `NUMBER`, and any JSON value otherwise.
+type NamedTestCaseVariableValueRequest struct {
+	Type  VellumVariableType `json:"type"`
+	Name  string             `json:"name"`
+	Value interface{}        `json:"value"`
+}
+
+// A single operation of a bulk Test Case request. Data is a
+// *TestSuiteTestCaseRequest for `upsert` operations and a
+// *TestSuiteTestCaseDeleteBulkOperationDataRequest for `delete` operations.
+type TestSuiteTestCaseBulkOperationRequest struct {
+	// An ID representing this specific operation. Can later be used to look up information about the operation's success in the response.
+	Id   string                             `json:"id"`
+	Type TestSuiteTestCaseBulkOperationType `json:"type"`
+	Data interface{}                        `json:"data"`
+}
+
+type TestSuiteTestCaseDeleteBulkOperationDataRequest struct {
+	Id string `json:"id"`
+}
+
+// - `upsert` - Upsert
+// - `delete` - Delete
+type TestSuiteTestCaseBulkOperationType string
+
+const (
+	TestSuiteTestCaseBulkOperationTypeUpsert TestSuiteTestCaseBulkOperationType = "upsert"
+	TestSuiteTestCaseBulkOperationTypeDelete TestSuiteTestCaseBulkOperationType = "delete"
+)
+
+func NewTestSuiteTestCaseBulkOperationTypeFromString(s string) (TestSuiteTestCaseBulkOperationType, error) {
+	switch s {
+	case "upsert":
+		return TestSuiteTestCaseBulkOperationTypeUpsert, nil
+	case "delete":
+		return TestSuiteTestCaseBulkOperationTypeDelete, nil
+	}
+	var t TestSuiteTestCaseBulkOperationType
+	return "", fmt.Errorf("%s is not a valid %T", s, t)
+}
+
+func (t TestSuiteTestCaseBulkOperationType) Ptr() *TestSuiteTestCaseBulkOperationType {
+	return &t
+}
@@ -0,0 +1,260 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package testsuites
+
+import (
+	context "context"
+	fmt "fmt"
+	http "net/http"
+	url "net/url"
+	vellumclientgo "terraform-provider-vellum/internal/sdk"
+	core "terraform-provider-vellum/internal/sdk/core"
+)
+
+type Client struct {
+	environment *core.Environment
+	caller      *core.Caller
+	header      http.Header
+}
+
+func NewClient(opts ...core.ClientOption) *Client {
+	options := core.NewClientOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+	environment, err := options.ResolveEnvironment()
+	return &Client{
+		environment: environment,
+		caller: core.NewCaller(
+			&core.CallerParams{
+				Client:         options.HTTPClient,
+				ReadOnly:       options.ReadOnly,
+				Logging:        options.Logging,
+				TracerProvider: options.TracerProvider,
+				Cache:          options.Cache,
+				Err:            err,
+			},
+		),
+		header: options.ToHeader(),
+	}
+}
+
+// Used to create a new Test Suite.
+func (c *Client) Create(ctx context.Context, request *vellumclientgo.TestSuiteCreateRequest) (*vellumclientgo.TestSuiteRead, error) {
+	endpointURL := c.environment.Default + "/" + "v1/test-suites"
+
+	var response *vellumclientgo.TestSuiteRead
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodPost,
+			Headers:  c.header,
+			Request:  request,
+			Response: &response,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Used to retrieve a Test Suite given its ID or name.
+//
+// Either the Test Suite's ID or its unique name
+func (c *Client) Retrieve(ctx context.Context, id string) (*vellumclientgo.TestSuiteRead, error) {
+	endpointURL := fmt.Sprintf(c.environment.Default+"/"+"v1/test-suites/%v", id)
+
+	var response *vellumclientgo.TestSuiteRead
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodGet,
+			Headers:  c.header,
+			Response: &response,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Used to partial update a Test Suite given its ID.
+//
+// A UUID string identifying this test suite.
+func (c *Client) PartialUpdate(ctx context.Context, id string, request *vellumclientgo.PatchedTestSuiteUpdateRequest) (*vellumclientgo.TestSuiteRead, error) {
+	endpointURL := fmt.Sprintf(c.environment.Default+"/"+"v1/test-suites/%v", id)
+
+	var response *vellumclientgo.TestSuiteRead
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodPatch,
+			Headers:  c.header,
+			Request:  request,
+			Response: &response,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Used to delete a Test Suite given its ID.
+//
+// A UUID string identifying this test suite.
+func (c *Client) Destroy(ctx context.Context, id string) error {
+	endpointURL := fmt.Sprintf(c.environment.Default+"/"+"v1/test-suites/%v", id)
+
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:     endpointURL,
+			Method:  http.MethodDelete,
+			Headers: c.header,
+		},
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// List the Test Cases associated with a Test Suite
+//
+// A UUID string identifying this test suite.
+func (c *Client) ListTestSuiteTestCases(ctx context.Context, id string, request *vellumclientgo.TestSuitesListTestSuiteTestCasesRequest) (*vellumclientgo.PaginatedTestSuiteTestCaseList, error) {
+	return c.listTestSuiteTestCases(ctx, c.listTestSuiteTestCasesURL(id, request))
+}
+
+// TestSuiteTestCasePages returns a *core.Pager that walks every page of
+// Test Cases, starting from the given request.
+func (c *Client) TestSuiteTestCasePages(id string, request *vellumclientgo.TestSuitesListTestSuiteTestCasesRequest, opts ...core.PageOption) *core.Pager[*vellumclientgo.TestSuiteTestCase] {
+	return core.NewPager(
+		c.listTestSuiteTestCasesURL(id, request),
+		func(ctx context.Context, url string) (*core.Page[*vellumclientgo.TestSuiteTestCase], error) {
+			response, err := c.listTestSuiteTestCases(ctx, url)
+			if err != nil {
+				return nil, err
+			}
+			if response == nil {
+				return nil, fmt.Errorf("%w: %s", core.ErrEmptyPage, url)
+			}
+			page := &core.Page[*vellumclientgo.TestSuiteTestCase]{Results: response.Results}
+			if response.Next != nil {
+				page.Next = *response.Next
+			}
+			return page, nil
+		},
+		opts...,
+	)
+}
+
+// ListAllTestSuiteTestCases returns the Test Cases of every page, starting
+// from the given request.
+func (c *Client) ListAllTestSuiteTestCases(ctx context.Context, id string, request *vellumclientgo.TestSuitesListTestSuiteTestCasesRequest, opts ...core.PageOption) ([]*vellumclientgo.TestSuiteTestCase, error) {
+	return c.TestSuiteTestCasePages(id, request, opts...).All(ctx)
+}
+
+func (c *Client) listTestSuiteTestCasesURL(id string, request *vellumclientgo.TestSuitesListTestSuiteTestCasesRequest) string {
+	endpointURL := fmt.Sprintf(c.environment.Default+"/"+"v1/test-suites/%v/test-cases", id)
+
+	queryParams := make(url.Values)
+	if request.Limit != nil {
+		queryParams.Add("limit", fmt.Sprintf("%v", *request.Limit))
+	}
+	if request.Offset != nil {
+		queryParams.Add("offset", fmt.Sprintf("%v", *request.Offset))
+	}
+	if len(queryParams) > 0 {
+		endpointURL += "?" + queryParams.Encode()
+	}
+	return endpointURL
+}
+
+func (c *Client) listTestSuiteTestCases(ctx context.Context, endpointURL string) (*vellumclientgo.PaginatedTestSuiteTestCaseList, error) {
+	var response *vellumclientgo.PaginatedTestSuiteTestCaseList
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodGet,
+			Headers:  c.header,
+			Response: &response,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Upserts a new test case for a test suite, keying off of the optionally provided test case id.
+//
+// If an id is provided and has a match, the test case will be updated. If no id is provided or no match
+// is found, a new test case will be appended to the end.
+//
+// Note that a full replacement of the test case is performed, so any fields not provided will be removed
+// or overwritten with default values.
+//
+// A UUID string identifying this test suite.
+func (c *Client) UpsertTestSuiteTestCase(ctx context.Context, id string, request *vellumclientgo.TestSuiteTestCaseRequest) (*vellumclientgo.TestSuiteTestCase, error) {
+	endpointURL := fmt.Sprintf(c.environment.Default+"/"+"v1/test-suites/%v/test-cases", id)
+
+	var response *vellumclientgo.TestSuiteTestCase
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodPost,
+			Headers:  c.header,
+			Request:  request,
+			Response: &response,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Deletes an existing test case for a test suite, keying off of the test case id.
+//
+// A UUID string identifying this test suite.
+// An id identifying the test case that you'd like to delete
+func (c *Client) DeleteTestSuiteTestCase(ctx context.Context, id string, testCaseId string) error {
+	endpointURL := fmt.Sprintf(c.environment.Default+"/"+"v1/test-suites/%v/test-cases/%v", id, testCaseId)
+
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:     endpointURL,
+			Method:  http.MethodDelete,
+			Headers: c.header,
+		},
+	); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Upserts and deletes Test Cases of a Test Suite in bulk, returning the outcome of every operation.
+//
+// A UUID string identifying this test suite.
+func (c *Client) TestSuiteTestCasesBulk(ctx context.Context, id string, request []*vellumclientgo.TestSuiteTestCaseBulkOperationRequest) ([]*vellumclientgo.TestSuiteTestCaseBulkResult, error) {
+	endpointURL := fmt.Sprintf(c.environment.Default+"/"+"v1/test-suites/%v/test-cases-bulk", id)
+
+	var response []*vellumclientgo.TestSuiteTestCaseBulkResult
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodPost,
+			Headers:  c.header,
+			Request:  request,
+			Response: &response,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
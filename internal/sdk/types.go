@@ -287,3 +287,1561 @@ func (p *PaginatedMlModelReadList) String() string {
 	}
 	return fmt.Sprintf("%#v", p)
 }
+
+// - `QUEUED` - Queued
+// - `PROCESSING` - Processing
+// - `PROCESSED` - Processed
+// - `FAILED` - Failed
+type ProcessingStateEnum string
+
+const (
+	ProcessingStateEnumQueued     ProcessingStateEnum = "QUEUED"
+	ProcessingStateEnumProcessing ProcessingStateEnum = "PROCESSING"
+	ProcessingStateEnumProcessed  ProcessingStateEnum = "PROCESSED"
+	ProcessingStateEnumFailed     ProcessingStateEnum = "FAILED"
+)
+
+func NewProcessingStateEnumFromString(s string) (ProcessingStateEnum, error) {
+	switch s {
+	case "QUEUED":
+		return ProcessingStateEnumQueued, nil
+	case "PROCESSING":
+		return ProcessingStateEnumProcessing, nil
+	case "PROCESSED":
+		return ProcessingStateEnumProcessed, nil
+	case "FAILED":
+		return ProcessingStateEnumFailed, nil
+	}
+	var t ProcessingStateEnum
+	return "", fmt.Errorf("%s is not a valid %T", s, t)
+}
+
+func (p ProcessingStateEnum) Ptr() *ProcessingStateEnum {
+	return &p
+}
+
+// - `EXCEEDED_CHARACTER_LIMIT` - Exceeded Character Limit
+// - `INVALID_FILE` - Invalid File
+type ProcessingFailureReasonEnum string
+
+const (
+	ProcessingFailureReasonEnumExceededCharacterLimit ProcessingFailureReasonEnum = "EXCEEDED_CHARACTER_LIMIT"
+	ProcessingFailureReasonEnumInvalidFile            ProcessingFailureReasonEnum = "INVALID_FILE"
+)
+
+func NewProcessingFailureReasonEnumFromString(s string) (ProcessingFailureReasonEnum, error) {
+	switch s {
+	case "EXCEEDED_CHARACTER_LIMIT":
+		return ProcessingFailureReasonEnumExceededCharacterLimit, nil
+	case "INVALID_FILE":
+		return ProcessingFailureReasonEnumInvalidFile, nil
+	}
+	var t ProcessingFailureReasonEnum
+	return "", fmt.Errorf("%s is not a valid %T", s, t)
+}
+
+func (p ProcessingFailureReasonEnum) Ptr() *ProcessingFailureReasonEnum {
+	return &p
+}
+
+// - `AWAITING_PROCESSING` - Awaiting Processing
+// - `QUEUED` - Queued
+// - `INDEXING` - Indexing
+// - `INDEXED` - Indexed
+// - `FAILED` - Failed
+type IndexingStateEnum string
+
+const (
+	IndexingStateEnumAwaitingProcessing IndexingStateEnum = "AWAITING_PROCESSING"
+	IndexingStateEnumQueued             IndexingStateEnum = "QUEUED"
+	IndexingStateEnumIndexing           IndexingStateEnum = "INDEXING"
+	IndexingStateEnumIndexed            IndexingStateEnum = "INDEXED"
+	IndexingStateEnumFailed             IndexingStateEnum = "FAILED"
+)
+
+func NewIndexingStateEnumFromString(s string) (IndexingStateEnum, error) {
+	switch s {
+	case "AWAITING_PROCESSING":
+		return IndexingStateEnumAwaitingProcessing, nil
+	case "QUEUED":
+		return IndexingStateEnumQueued, nil
+	case "INDEXING":
+		return IndexingStateEnumIndexing, nil
+	case "INDEXED":
+		return IndexingStateEnumIndexed, nil
+	case "FAILED":
+		return IndexingStateEnumFailed, nil
+	}
+	var t IndexingStateEnum
+	return "", fmt.Errorf("%s is not a valid %T", s, t)
+}
+
+func (i IndexingStateEnum) Ptr() *IndexingStateEnum {
+	return &i
+}
+
+// - `ACTIVE` - Active
+type DocumentStatus string
+
+const (
+	DocumentStatusActive DocumentStatus = "ACTIVE"
+)
+
+func NewDocumentStatusFromString(s string) (DocumentStatus, error) {
+	switch s {
+	case "ACTIVE":
+		return DocumentStatusActive, nil
+	}
+	var t DocumentStatus
+	return "", fmt.Errorf("%s is not a valid %T", s, t)
+}
+
+func (d DocumentStatus) Ptr() *DocumentStatus {
+	return &d
+}
+
+// A detailed representation of the link between a Document and a Document Index it's a member of.
+type DocumentDocumentToDocumentIndex struct {
+	// Vellum-generated ID that uniquely identifies this link.
+	Id string `json:"id"`
+	// Vellum-generated ID that uniquely identifies the index this document is included in.
+	DocumentIndexId string `json:"document_index_id"`
+	// An enum value representing where this document is along its indexing lifecycle for this index.
+	//
+	// - `AWAITING_PROCESSING` - Awaiting Processing
+	// - `QUEUED` - Queued
+	// - `INDEXING` - Indexing
+	// - `INDEXED` - Indexed
+	// - `FAILED` - Failed
+	IndexingState *IndexingStateEnum `json:"indexing_state,omitempty"`
+
+	_rawJSON json.RawMessage
+}
+
+func (d *DocumentDocumentToDocumentIndex) UnmarshalJSON(data []byte) error {
+	type unmarshaler DocumentDocumentToDocumentIndex
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*d = DocumentDocumentToDocumentIndex(value)
+	d._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (d *DocumentDocumentToDocumentIndex) String() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+type SlimDocument struct {
+	// Vellum-generated ID that uniquely identifies this document.
+	Id string `json:"id"`
+	// The external ID that was originally provided when uploading the document.
+	ExternalId *string `json:"external_id,omitempty"`
+	// A timestamp representing when this document was most recently uploaded.
+	LastUploadedAt time.Time `json:"last_uploaded_at"`
+	// Human-friendly name for this document.
+	Label string `json:"label"`
+	// An enum value representing where this document is along its processing lifecycle. Note that this is different than its indexing lifecycle.
+	//
+	// - `QUEUED` - Queued
+	// - `PROCESSING` - Processing
+	// - `PROCESSED` - Processed
+	// - `FAILED` - Failed
+	ProcessingState *ProcessingStateEnum `json:"processing_state,omitempty"`
+	// An enum value representing why the document could not be processed. Is null unless processing_state is FAILED.
+	//
+	// - `EXCEEDED_CHARACTER_LIMIT` - Exceeded Character Limit
+	// - `INVALID_FILE` - Invalid File
+	ProcessingFailureReason *ProcessingFailureReasonEnum `json:"processing_failure_reason,omitempty"`
+	// The document's current status.
+	//
+	// - `ACTIVE` - Active
+	Status *DocumentStatus `json:"status,omitempty"`
+	// A list of keywords associated with this document. Originally provided when uploading the document.
+	Keywords []string `json:"keywords,omitempty"`
+	// A previously supplied JSON object containing metadata that can be filtered on when searching.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+	// A list of document indexes that this document is included in.
+	DocumentToDocumentIndexes []*DocumentDocumentToDocumentIndex `json:"document_to_document_indexes,omitempty"`
+
+	_rawJSON json.RawMessage
+}
+
+func (s *SlimDocument) UnmarshalJSON(data []byte) error {
+	type unmarshaler SlimDocument
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*s = SlimDocument(value)
+	s._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (s *SlimDocument) String() string {
+	if len(s._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(s._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(s); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", s)
+}
+
+type PaginatedSlimDocumentList struct {
+	Count    *int            `json:"count,omitempty"`
+	Next     *string         `json:"next,omitempty"`
+	Previous *string         `json:"previous,omitempty"`
+	Results  []*SlimDocument `json:"results,omitempty"`
+
+	_rawJSON json.RawMessage
+}
+
+func (p *PaginatedSlimDocumentList) UnmarshalJSON(data []byte) error {
+	type unmarshaler PaginatedSlimDocumentList
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*p = PaginatedSlimDocumentList(value)
+	p._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (p *PaginatedSlimDocumentList) String() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+type DocumentRead struct {
+	Id string `json:"id"`
+	// The unique id of this document as it exists in the user's system.
+	ExternalId     *string   `json:"external_id,omitempty"`
+	LastUploadedAt time.Time `json:"last_uploaded_at"`
+	// A human-readable label for the document. Defaults to the originally uploaded file's file name.
+	Label string `json:"label"`
+	// - `QUEUED` - Queued
+	// - `PROCESSING` - Processing
+	// - `PROCESSED` - Processed
+	// - `FAILED` - Failed
+	ProcessingState *ProcessingStateEnum `json:"processing_state,omitempty"`
+	// - `EXCEEDED_CHARACTER_LIMIT` - Exceeded Character Limit
+	// - `INVALID_FILE` - Invalid File
+	ProcessingFailureReason *ProcessingFailureReasonEnum `json:"processing_failure_reason,omitempty"`
+	// The current status of the document
+	//
+	// - `ACTIVE` - Active
+	Status                    *DocumentStatus                    `json:"status,omitempty"`
+	OriginalFileUrl           *string                            `json:"original_file_url,omitempty"`
+	ProcessedFileUrl          *string                            `json:"processed_file_url,omitempty"`
+	DocumentToDocumentIndexes []*DocumentDocumentToDocumentIndex `json:"document_to_document_indexes,omitempty"`
+	// A previously supplied JSON object containing metadata that can be filtered on when searching.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+
+	_rawJSON json.RawMessage
+}
+
+func (d *DocumentRead) UnmarshalJSON(data []byte) error {
+	type unmarshaler DocumentRead
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*d = DocumentRead(value)
+	d._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (d *DocumentRead) String() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+type UploadDocumentResponse struct {
+	// The ID of the newly created document.
+	DocumentId string `json:"document_id"`
+
+	_rawJSON json.RawMessage
+}
+
+func (u *UploadDocumentResponse) UnmarshalJSON(data []byte) error {
+	type unmarshaler UploadDocumentResponse
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*u = UploadDocumentResponse(value)
+	u._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (u *UploadDocumentResponse) String() string {
+	if len(u._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(u._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(u); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", u)
+}
+
+// - `and` - AND
+// - `or` - OR
+type MetadataFilterRuleCombinator string
+
+const (
+	MetadataFilterRuleCombinatorAnd MetadataFilterRuleCombinator = "and"
+	MetadataFilterRuleCombinatorOr  MetadataFilterRuleCombinator = "or"
+)
+
+func NewMetadataFilterRuleCombinatorFromString(s string) (MetadataFilterRuleCombinator, error) {
+	switch s {
+	case "and":
+		return MetadataFilterRuleCombinatorAnd, nil
+	case "or":
+		return MetadataFilterRuleCombinatorOr, nil
+	}
+	var t MetadataFilterRuleCombinator
+	return "", fmt.Errorf("%s is not a valid %T", s, t)
+}
+
+func (m MetadataFilterRuleCombinator) Ptr() *MetadataFilterRuleCombinator {
+	return &m
+}
+
+// - `=` - EQUALS
+// - `!=` - DOES_NOT_EQUAL
+// - `<` - LESS_THAN
+// - `>` - GREATER_THAN
+// - `<=` - LESS_THAN_OR_EQUAL_TO
+// - `>=` - GREATER_THAN_OR_EQUAL_TO
+// - `contains` - CONTAINS
+// - `beginsWith` - BEGINS_WITH
+// - `endsWith` - ENDS_WITH
+// - `doesNotContain` - DOES_NOT_CONTAIN
+// - `doesNotBeginWith` - DOES_NOT_BEGIN_WITH
+// - `doesNotEndWith` - DOES_NOT_END_WITH
+// - `null` - NULL
+// - `notNull` - NOT_NULL
+// - `in` - IN
+// - `notIn` - NOT_IN
+// - `between` - BETWEEN
+// - `notBetween` - NOT_BETWEEN
+type LogicalOperator string
+
+const (
+	LogicalOperatorEquals               LogicalOperator = "="
+	LogicalOperatorDoesNotEqual         LogicalOperator = "!="
+	LogicalOperatorLessThan             LogicalOperator = "<"
+	LogicalOperatorGreaterThan          LogicalOperator = ">"
+	LogicalOperatorLessThanOrEqualTo    LogicalOperator = "<="
+	LogicalOperatorGreaterThanOrEqualTo LogicalOperator = ">="
+	LogicalOperatorContains             LogicalOperator = "contains"
+	LogicalOperatorBeginsWith           LogicalOperator = "beginsWith"
+	LogicalOperatorEndsWith             LogicalOperator = "endsWith"
+	LogicalOperatorDoesNotContain       LogicalOperator = "doesNotContain"
+	LogicalOperatorDoesNotBeginWith     LogicalOperator = "doesNotBeginWith"
+	LogicalOperatorDoesNotEndWith       LogicalOperator = "doesNotEndWith"
+	LogicalOperatorNull                 LogicalOperator = "null"
+	LogicalOperatorNotNull              LogicalOperator = "notNull"
+	LogicalOperatorIn                   LogicalOperator = "in"
+	LogicalOperatorNotIn                LogicalOperator = "notIn"
+	LogicalOperatorBetween              LogicalOperator = "between"
+	LogicalOperatorNotBetween           LogicalOperator = "notBetween"
+)
+
+func NewLogicalOperatorFromString(s string) (LogicalOperator, error) {
+	switch s {
+	case "=":
+		return LogicalOperatorEquals, nil
+	case "!=":
+		return LogicalOperatorDoesNotEqual, nil
+	case "<":
+		return LogicalOperatorLessThan, nil
+	case ">":
+		return LogicalOperatorGreaterThan, nil
+	case "<=":
+		return LogicalOperatorLessThanOrEqualTo, nil
+	case ">=":
+		return LogicalOperatorGreaterThanOrEqualTo, nil
+	case "contains":
+		return LogicalOperatorContains, nil
+	case "beginsWith":
+		return LogicalOperatorBeginsWith, nil
+	case "endsWith":
+		return LogicalOperatorEndsWith, nil
+	case "doesNotContain":
+		return LogicalOperatorDoesNotContain, nil
+	case "doesNotBeginWith":
+		return LogicalOperatorDoesNotBeginWith, nil
+	case "doesNotEndWith":
+		return LogicalOperatorDoesNotEndWith, nil
+	case "null":
+		return LogicalOperatorNull, nil
+	case "notNull":
+		return LogicalOperatorNotNull, nil
+	case "in":
+		return LogicalOperatorIn, nil
+	case "notIn":
+		return LogicalOperatorNotIn, nil
+	case "between":
+		return LogicalOperatorBetween, nil
+	case "notBetween":
+		return LogicalOperatorNotBetween, nil
+	}
+	var t LogicalOperator
+	return "", fmt.Errorf("%s is not a valid %T", s, t)
+}
+
+func (l LogicalOperator) Ptr() *LogicalOperator {
+	return &l
+}
+
+type SearchResultDocument struct {
+	// The ID of the document.
+	Id *string `json:"id,omitempty"`
+	// The human-readable name for the document.
+	Label string `json:"label"`
+	// The unique ID of the document as represented in an external system and specified when it was originally uploaded.
+	ExternalId *string `json:"external_id,omitempty"`
+	// A previously supplied JSON object containing metadata that can be filtered on when searching.
+	Metadata map[string]interface{} `json:"metadata,omitempty"`
+
+	_rawJSON json.RawMessage
+}
+
+func (s *SearchResultDocument) UnmarshalJSON(data []byte) error {
+	type unmarshaler SearchResultDocument
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*s = SearchResultDocument(value)
+	s._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (s *SearchResultDocument) String() string {
+	if len(s._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(s._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(s); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", s)
+}
+
+type SearchResult struct {
+	// The text of the chunk that matched your search query.
+	Text string `json:"text"`
+	// A score representing how well the chunk matches your search query.
+	Score    float64  `json:"score"`
+	Keywords []string `json:"keywords,omitempty"`
+	// The document that contains the chunk that matched your search query.
+	Document *SearchResultDocument `json:"document,omitempty"`
+
+	_rawJSON json.RawMessage
+}
+
+func (s *SearchResult) UnmarshalJSON(data []byte) error {
+	type unmarshaler SearchResult
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*s = SearchResult(value)
+	s._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (s *SearchResult) String() string {
+	if len(s._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(s._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(s); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", s)
+}
+
+type SearchResponse struct {
+	// The results of the search. Each result represents a chunk that matches the search query.
+	Results []*SearchResult `json:"results,omitempty"`
+
+	_rawJSON json.RawMessage
+}
+
+func (s *SearchResponse) UnmarshalJSON(data []byte) error {
+	type unmarshaler SearchResponse
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*s = SearchResponse(value)
+	s._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (s *SearchResponse) String() string {
+	if len(s._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(s._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(s); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", s)
+}
+
+type DeploymentRead struct {
+	Id      string    `json:"id"`
+	Created time.Time `json:"created"`
+	// A human-readable label for the deployment
+	Label string `json:"label"`
+	// A name that uniquely identifies this deployment within its workspace
+	Name string `json:"name"`
+	// A human-readable description of the deployment
+	Description *string `json:"description,omitempty"`
+	// The current status of the deployment
+	//
+	// - `ACTIVE` - Active
+	// - `ARCHIVED` - Archived
+	Status *EntityStatus `json:"status,omitempty"`
+	// The environment this deployment is used in
+	//
+	// - `DEVELOPMENT` - Development
+	// - `STAGING` - Staging
+	// - `PRODUCTION` - Production
+	Environment *EnvironmentEnum `json:"environment,omitempty"`
+	// When the deployment's active release was deployed
+	LastDeployedOn *time.Time `json:"last_deployed_on,omitempty"`
+	// The ID of the release history item the deployment currently serves
+	LastDeployedHistoryItemId *string `json:"last_deployed_history_item_id,omitempty"`
+	// The IDs of the ML Model versions the active release runs against
+	ActiveModelVersionIds []string `json:"active_model_version_ids,omitempty"`
+
+	_rawJSON json.RawMessage
+}
+
+func (d *DeploymentRead) UnmarshalJSON(data []byte) error {
+	type unmarshaler DeploymentRead
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*d = DeploymentRead(value)
+	d._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (d *DeploymentRead) String() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+type SlimDeploymentRead struct {
+	Id      string    `json:"id"`
+	Created time.Time `json:"created"`
+	// A human-readable label for the deployment
+	Label string `json:"label"`
+	// A name that uniquely identifies this deployment within its workspace
+	Name string `json:"name"`
+	// The current status of the deployment
+	//
+	// - `ACTIVE` - Active
+	// - `ARCHIVED` - Archived
+	Status *EntityStatus `json:"status,omitempty"`
+	// The environment this deployment is used in
+	//
+	// - `DEVELOPMENT` - Development
+	// - `STAGING` - Staging
+	// - `PRODUCTION` - Production
+	Environment *EnvironmentEnum `json:"environment,omitempty"`
+	// When the deployment's active release was deployed
+	LastDeployedOn *time.Time `json:"last_deployed_on,omitempty"`
+
+	_rawJSON json.RawMessage
+}
+
+func (s *SlimDeploymentRead) UnmarshalJSON(data []byte) error {
+	type unmarshaler SlimDeploymentRead
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*s = SlimDeploymentRead(value)
+	s._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (s *SlimDeploymentRead) String() string {
+	if len(s._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(s._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(s); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", s)
+}
+
+type PaginatedSlimDeploymentReadList struct {
+	Count    *int                  `json:"count,omitempty"`
+	Next     *string               `json:"next,omitempty"`
+	Previous *string               `json:"previous,omitempty"`
+	Results  []*SlimDeploymentRead `json:"results,omitempty"`
+
+	_rawJSON json.RawMessage
+}
+
+func (p *PaginatedSlimDeploymentReadList) UnmarshalJSON(data []byte) error {
+	type unmarshaler PaginatedSlimDeploymentReadList
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*p = PaginatedSlimDeploymentReadList(value)
+	p._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (p *PaginatedSlimDeploymentReadList) String() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// - `STRING` - STRING
+// - `NUMBER` - NUMBER
+// - `JSON` - JSON
+// - `CHAT_HISTORY` - CHAT_HISTORY
+// - `SEARCH_RESULTS` - SEARCH_RESULTS
+// - `ERROR` - ERROR
+// - `ARRAY` - ARRAY
+// - `FUNCTION_CALL` - FUNCTION_CALL
+// - `IMAGE` - IMAGE
+// - `NULL` - NULL
+type VellumVariableType string
+
+const (
+	VellumVariableTypeString        VellumVariableType = "STRING"
+	VellumVariableTypeNumber        VellumVariableType = "NUMBER"
+	VellumVariableTypeJson          VellumVariableType = "JSON"
+	VellumVariableTypeChatHistory   VellumVariableType = "CHAT_HISTORY"
+	VellumVariableTypeSearchResults VellumVariableType = "SEARCH_RESULTS"
+	VellumVariableTypeError         VellumVariableType = "ERROR"
+	VellumVariableTypeArray         VellumVariableType = "ARRAY"
+	VellumVariableTypeFunctionCall  VellumVariableType = "FUNCTION_CALL"
+	VellumVariableTypeImage         VellumVariableType = "IMAGE"
+	VellumVariableTypeNull          VellumVariableType = "NULL"
+)
+
+func NewVellumVariableTypeFromString(s string) (VellumVariableType, error) {
+	switch s {
+	case "STRING":
+		return VellumVariableTypeString, nil
+	case "NUMBER":
+		return VellumVariableTypeNumber, nil
+	case "JSON":
+		return VellumVariableTypeJson, nil
+	case "CHAT_HISTORY":
+		return VellumVariableTypeChatHistory, nil
+	case "SEARCH_RESULTS":
+		return VellumVariableTypeSearchResults, nil
+	case "ERROR":
+		return VellumVariableTypeError, nil
+	case "ARRAY":
+		return VellumVariableTypeArray, nil
+	case "FUNCTION_CALL":
+		return VellumVariableTypeFunctionCall, nil
+	case "IMAGE":
+		return VellumVariableTypeImage, nil
+	case "NULL":
+		return VellumVariableTypeNull, nil
+	}
+	var t VellumVariableType
+	return "", fmt.Errorf("%s is not a valid %T", s, t)
+}
+
+func (v VellumVariableType) Ptr() *VellumVariableType {
+	return &v
+}
+
+// - `SYSTEM` - System
+// - `USER` - User
+type ReleaseTagSource string
+
+const (
+	ReleaseTagSourceSystem ReleaseTagSource = "SYSTEM"
+	ReleaseTagSourceUser   ReleaseTagSource = "USER"
+)
+
+func NewReleaseTagSourceFromString(s string) (ReleaseTagSource, error) {
+	switch s {
+	case "SYSTEM":
+		return ReleaseTagSourceSystem, nil
+	case "USER":
+		return ReleaseTagSourceUser, nil
+	}
+	var t ReleaseTagSource
+	return "", fmt.Errorf("%s is not a valid %T", s, t)
+}
+
+func (r ReleaseTagSource) Ptr() *ReleaseTagSource {
+	return &r
+}
+
+type VellumVariable struct {
+	Id       string             `json:"id"`
+	Key      string             `json:"key"`
+	Type     VellumVariableType `json:"type"`
+	Required *bool              `json:"required,omitempty"`
+
+	_rawJSON json.RawMessage
+}
+
+func (v *VellumVariable) UnmarshalJSON(data []byte) error {
+	type unmarshaler VellumVariable
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*v = VellumVariable(value)
+	v._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (v *VellumVariable) String() string {
+	if len(v._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(v._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(v); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", v)
+}
+
+type WorkflowDeploymentRead struct {
+	Id string `json:"id"`
+	// A name that uniquely identifies this workflow deployment within its workspace
+	Name string `json:"name"`
+	// A human-readable label for the workflow deployment
+	Label string `json:"label"`
+	// The current status of the workflow deployment
+	//
+	// - `ACTIVE` - Active
+	// - `ARCHIVED` - Archived
+	Status *EntityStatus `json:"status,omitempty"`
+	// The environment this workflow deployment is used in
+	//
+	// - `DEVELOPMENT` - Development
+	// - `STAGING` - Staging
+	// - `PRODUCTION` - Production
+	Environment    *EnvironmentEnum `json:"environment,omitempty"`
+	Created        time.Time        `json:"created"`
+	LastDeployedOn time.Time        `json:"last_deployed_on"`
+	// The ID of the history item associated with this Workflow Deployment's LATEST Release Tag
+	LastDeployedHistoryItemId string `json:"last_deployed_history_item_id"`
+	// The input variables this Workflow Deployment expects to receive values for when it is executed.
+	InputVariables []*VellumVariable `json:"input_variables,omitempty"`
+	// The output variables this Workflow Deployment produces values for when it's executed.
+	OutputVariables []*VellumVariable `json:"output_variables,omitempty"`
+	// A human-readable description of the workflow deployment
+	Description *string `json:"description,omitempty"`
+
+	_rawJSON json.RawMessage
+}
+
+func (w *WorkflowDeploymentRead) UnmarshalJSON(data []byte) error {
+	type unmarshaler WorkflowDeploymentRead
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*w = WorkflowDeploymentRead(value)
+	w._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (w *WorkflowDeploymentRead) String() string {
+	if len(w._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(w._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(w); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", w)
+}
+
+type SlimWorkflowDeployment struct {
+	Id string `json:"id"`
+	// A name that uniquely identifies this workflow deployment within its workspace
+	Name string `json:"name"`
+	// A human-readable label for the workflow deployment
+	Label string `json:"label"`
+	// The current status of the workflow deployment
+	//
+	// - `ACTIVE` - Active
+	// - `ARCHIVED` - Archived
+	Status *EntityStatus `json:"status,omitempty"`
+	// The environment this workflow deployment is used in
+	//
+	// - `DEVELOPMENT` - Development
+	// - `STAGING` - Staging
+	// - `PRODUCTION` - Production
+	Environment    *EnvironmentEnum `json:"environment,omitempty"`
+	Created        time.Time        `json:"created"`
+	LastDeployedOn time.Time        `json:"last_deployed_on"`
+
+	_rawJSON json.RawMessage
+}
+
+func (s *SlimWorkflowDeployment) UnmarshalJSON(data []byte) error {
+	type unmarshaler SlimWorkflowDeployment
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*s = SlimWorkflowDeployment(value)
+	s._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (s *SlimWorkflowDeployment) String() string {
+	if len(s._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(s._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(s); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", s)
+}
+
+type PaginatedSlimWorkflowDeploymentList struct {
+	Count    *int                      `json:"count,omitempty"`
+	Next     *string                   `json:"next,omitempty"`
+	Previous *string                   `json:"previous,omitempty"`
+	Results  []*SlimWorkflowDeployment `json:"results,omitempty"`
+
+	_rawJSON json.RawMessage
+}
+
+func (p *PaginatedSlimWorkflowDeploymentList) UnmarshalJSON(data []byte) error {
+	type unmarshaler PaginatedSlimWorkflowDeploymentList
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*p = PaginatedSlimWorkflowDeploymentList(value)
+	p._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (p *PaginatedSlimWorkflowDeploymentList) String() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+type WorkflowReleaseTagWorkflowDeploymentHistoryItem struct {
+	// The ID of the Workflow Deployment History Item
+	Id string `json:"id"`
+	// The timestamp representing when this History Item was created
+	Timestamp time.Time `json:"timestamp"`
+
+	_rawJSON json.RawMessage
+}
+
+func (w *WorkflowReleaseTagWorkflowDeploymentHistoryItem) UnmarshalJSON(data []byte) error {
+	type unmarshaler WorkflowReleaseTagWorkflowDeploymentHistoryItem
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*w = WorkflowReleaseTagWorkflowDeploymentHistoryItem(value)
+	w._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (w *WorkflowReleaseTagWorkflowDeploymentHistoryItem) String() string {
+	if len(w._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(w._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(w); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", w)
+}
+
+type WorkflowReleaseTagRead struct {
+	// The name of the Release Tag
+	Name string `json:"name"`
+	// The source of how the Release Tag was originally created
+	//
+	// - `SYSTEM` - System
+	// - `USER` - User
+	Source ReleaseTagSource `json:"source"`
+	// The Workflow Deployment History Item that this Release Tag is associated with
+	HistoryItem *WorkflowReleaseTagWorkflowDeploymentHistoryItem `json:"history_item,omitempty"`
+
+	_rawJSON json.RawMessage
+}
+
+func (w *WorkflowReleaseTagRead) UnmarshalJSON(data []byte) error {
+	type unmarshaler WorkflowReleaseTagRead
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*w = WorkflowReleaseTagRead(value)
+	w._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (w *WorkflowReleaseTagRead) String() string {
+	if len(w._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(w._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(w); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", w)
+}
+
+type DeploymentReleaseTagDeploymentHistoryItem struct {
+	// The ID of the Deployment History Item
+	Id string `json:"id"`
+	// The timestamp representing when this History Item was created
+	Timestamp time.Time `json:"timestamp"`
+
+	_rawJSON json.RawMessage
+}
+
+func (d *DeploymentReleaseTagDeploymentHistoryItem) UnmarshalJSON(data []byte) error {
+	type unmarshaler DeploymentReleaseTagDeploymentHistoryItem
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*d = DeploymentReleaseTagDeploymentHistoryItem(value)
+	d._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (d *DeploymentReleaseTagDeploymentHistoryItem) String() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+type DeploymentReleaseTagRead struct {
+	// The name of the Release Tag
+	Name string `json:"name"`
+	// The source of how the Release Tag was originally created
+	//
+	// - `SYSTEM` - System
+	// - `USER` - User
+	Source ReleaseTagSource `json:"source"`
+	// The Deployment History Item that this Release Tag is associated with
+	HistoryItem *DeploymentReleaseTagDeploymentHistoryItem `json:"history_item,omitempty"`
+
+	_rawJSON json.RawMessage
+}
+
+func (d *DeploymentReleaseTagRead) UnmarshalJSON(data []byte) error {
+	type unmarshaler DeploymentReleaseTagRead
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*d = DeploymentReleaseTagRead(value)
+	d._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (d *DeploymentReleaseTagRead) String() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+type DeploymentHistoryItem struct {
+	Id           string    `json:"id"`
+	DeploymentId string    `json:"deployment_id"`
+	Timestamp    time.Time `json:"timestamp"`
+	// A human-readable label for the deployment
+	Label string `json:"label"`
+	// A name that uniquely identifies this deployment within its workspace
+	Name string `json:"name"`
+	// The input variables the deployment expected at the time of this release
+	InputVariables []*VellumVariable `json:"input_variables,omitempty"`
+	// A human-readable description of the deployment
+	Description *string `json:"description,omitempty"`
+
+	_rawJSON json.RawMessage
+}
+
+func (d *DeploymentHistoryItem) UnmarshalJSON(data []byte) error {
+	type unmarshaler DeploymentHistoryItem
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*d = DeploymentHistoryItem(value)
+	d._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (d *DeploymentHistoryItem) String() string {
+	if len(d._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(d._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(d); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", d)
+}
+
+// - `USER_DEFINED` - User Defined
+// - `HMAC` - Hmac
+// - `INTERNAL_API_KEY` - Internal Api Key
+type SecretTypeEnum string
+
+const (
+	SecretTypeEnumUserDefined    SecretTypeEnum = "USER_DEFINED"
+	SecretTypeEnumHmac           SecretTypeEnum = "HMAC"
+	SecretTypeEnumInternalApiKey SecretTypeEnum = "INTERNAL_API_KEY"
+)
+
+func NewSecretTypeEnumFromString(s string) (SecretTypeEnum, error) {
+	switch s {
+	case "USER_DEFINED":
+		return SecretTypeEnumUserDefined, nil
+	case "HMAC":
+		return SecretTypeEnumHmac, nil
+	case "INTERNAL_API_KEY":
+		return SecretTypeEnumInternalApiKey, nil
+	}
+	var t SecretTypeEnum
+	return "", fmt.Errorf("%s is not a valid %T", s, t)
+}
+
+func (s SecretTypeEnum) Ptr() *SecretTypeEnum {
+	return &s
+}
+
+// A workspace secret. Its value is never returned.
+type WorkspaceSecretRead struct {
+	Id       string    `json:"id"`
+	Modified time.Time `json:"modified"`
+	// A name that uniquely identifies this secret within its workspace
+	Name string `json:"name"`
+	// A human-readable label for the secret
+	Label string `json:"label"`
+	// - `USER_DEFINED` - User Defined
+	// - `HMAC` - Hmac
+	// - `INTERNAL_API_KEY` - Internal Api Key
+	SecretType SecretTypeEnum `json:"secret_type"`
+
+	_rawJSON json.RawMessage
+}
+
+func (w *WorkspaceSecretRead) UnmarshalJSON(data []byte) error {
+	type unmarshaler WorkspaceSecretRead
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*w = WorkspaceSecretRead(value)
+	w._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (w *WorkspaceSecretRead) String() string {
+	if len(w._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(w._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(w); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", w)
+}
+
+type TestSuiteRead struct {
+	Id       string    `json:"id"`
+	Created  time.Time `json:"created"`
+	Modified time.Time `json:"modified"`
+	// A name that uniquely identifies this test suite within its workspace
+	Name string `json:"name"`
+	// A human-readable label for the test suite
+	Label string `json:"label"`
+	// The variables every test case provides input values for
+	InputVariables []*VellumVariable `json:"input_variables,omitempty"`
+	// The variables every test case provides expected output values for
+	EvaluationVariables []*VellumVariable `json:"evaluation_variables,omitempty"`
+
+	_rawJSON json.RawMessage
+}
+
+func (t *TestSuiteRead) UnmarshalJSON(data []byte) error {
+	type unmarshaler TestSuiteRead
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*t = TestSuiteRead(value)
+	t._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (t *TestSuiteRead) String() string {
+	if len(t._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(t._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(t); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", t)
+}
+
+// A named Test Case variable value, typed as described by Type.
+type NamedTestCaseVariableValue struct {
+	Type  VellumVariableType `json:"type"`
+	Name  string             `json:"name"`
+	Value interface{}        `json:"value,omitempty"`
+
+	_rawJSON json.RawMessage
+}
+
+func (n *NamedTestCaseVariableValue) UnmarshalJSON(data []byte) error {
+	type unmarshaler NamedTestCaseVariableValue
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*n = NamedTestCaseVariableValue(value)
+	n._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (n *NamedTestCaseVariableValue) String() string {
+	if len(n._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(n._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(n); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", n)
+}
+
+type TestSuiteTestCase struct {
+	Id               *string                       `json:"id,omitempty"`
+	ExternalId       *string                       `json:"external_id,omitempty"`
+	Label            *string                       `json:"label,omitempty"`
+	InputValues      []*NamedTestCaseVariableValue `json:"input_values,omitempty"`
+	EvaluationValues []*NamedTestCaseVariableValue `json:"evaluation_values,omitempty"`
+
+	_rawJSON json.RawMessage
+}
+
+func (t *TestSuiteTestCase) UnmarshalJSON(data []byte) error {
+	type unmarshaler TestSuiteTestCase
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*t = TestSuiteTestCase(value)
+	t._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (t *TestSuiteTestCase) String() string {
+	if len(t._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(t._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(t); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", t)
+}
+
+type PaginatedTestSuiteTestCaseList struct {
+	Count    int                  `json:"count"`
+	Next     *string              `json:"next,omitempty"`
+	Previous *string              `json:"previous,omitempty"`
+	Results  []*TestSuiteTestCase `json:"results,omitempty"`
+
+	_rawJSON json.RawMessage
+}
+
+func (p *PaginatedTestSuiteTestCaseList) UnmarshalJSON(data []byte) error {
+	type unmarshaler PaginatedTestSuiteTestCaseList
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*p = PaginatedTestSuiteTestCaseList(value)
+	p._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (p *PaginatedTestSuiteTestCaseList) String() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// - `CREATED` - Created
+// - `REPLACED` - Replaced
+// - `DELETED` - Deleted
+// - `REJECTED` - Rejected
+type TestSuiteTestCaseBulkResultType string
+
+const (
+	TestSuiteTestCaseBulkResultTypeCreated  TestSuiteTestCaseBulkResultType = "CREATED"
+	TestSuiteTestCaseBulkResultTypeReplaced TestSuiteTestCaseBulkResultType = "REPLACED"
+	TestSuiteTestCaseBulkResultTypeDeleted  TestSuiteTestCaseBulkResultType = "DELETED"
+	TestSuiteTestCaseBulkResultTypeRejected TestSuiteTestCaseBulkResultType = "REJECTED"
+)
+
+func NewTestSuiteTestCaseBulkResultTypeFromString(s string) (TestSuiteTestCaseBulkResultType, error) {
+	switch s {
+	case "CREATED":
+		return TestSuiteTestCaseBulkResultTypeCreated, nil
+	case "REPLACED":
+		return TestSuiteTestCaseBulkResultTypeReplaced, nil
+	case "DELETED":
+		return TestSuiteTestCaseBulkResultTypeDeleted, nil
+	case "REJECTED":
+		return TestSuiteTestCaseBulkResultTypeRejected, nil
+	}
+	var t TestSuiteTestCaseBulkResultType
+	return "", fmt.Errorf("%s is not a valid %T", s, t)
+}
+
+func (t TestSuiteTestCaseBulkResultType) Ptr() *TestSuiteTestCaseBulkResultType {
+	return &t
+}
+
+// The outcome of a single operation of a bulk Test Case request.
+type TestSuiteTestCaseBulkResult struct {
+	// The ID of the operation this result is for
+	Id   string                          `json:"id"`
+	Type TestSuiteTestCaseBulkResultType `json:"type"`
+	// Details about the operation. For rejected operations, its `detail` explains why.
+	Data map[string]interface{} `json:"data,omitempty"`
+
+	_rawJSON json.RawMessage
+}
+
+func (t *TestSuiteTestCaseBulkResult) UnmarshalJSON(data []byte) error {
+	type unmarshaler TestSuiteTestCaseBulkResult
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*t = TestSuiteTestCaseBulkResult(value)
+	t._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (t *TestSuiteTestCaseBulkResult) String() string {
+	if len(t._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(t._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(t); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", t)
+}
+
+type FolderRead struct {
+	Id       string    `json:"id"`
+	Created  time.Time `json:"created"`
+	Modified time.Time `json:"modified"`
+	// The folder's name
+	Name string `json:"name"`
+	// The ID of the folder this folder is nested in, if any
+	ParentFolderId *string `json:"parent_folder_id,omitempty"`
+
+	_rawJSON json.RawMessage
+}
+
+func (f *FolderRead) UnmarshalJSON(data []byte) error {
+	type unmarshaler FolderRead
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*f = FolderRead(value)
+	f._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (f *FolderRead) String() string {
+	if len(f._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(f._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(f); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", f)
+}
+
+type PaginatedFolderReadList struct {
+	Count    int           `json:"count"`
+	Next     *string       `json:"next,omitempty"`
+	Previous *string       `json:"previous,omitempty"`
+	Results  []*FolderRead `json:"results,omitempty"`
+
+	_rawJSON json.RawMessage
+}
+
+func (p *PaginatedFolderReadList) UnmarshalJSON(data []byte) error {
+	type unmarshaler PaginatedFolderReadList
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*p = PaginatedFolderReadList(value)
+	p._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (p *PaginatedFolderReadList) String() string {
+	if len(p._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(p._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(p); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", p)
+}
+
+// - `WORKSPACE` - Workspace
+// - `ORGANIZATION` - Organization
+type ApiKeyScopeEnum string
+
+const (
+	ApiKeyScopeEnumWorkspace    ApiKeyScopeEnum = "WORKSPACE"
+	ApiKeyScopeEnumOrganization ApiKeyScopeEnum = "ORGANIZATION"
+)
+
+func NewApiKeyScopeEnumFromString(s string) (ApiKeyScopeEnum, error) {
+	switch s {
+	case "WORKSPACE":
+		return ApiKeyScopeEnumWorkspace, nil
+	case "ORGANIZATION":
+		return ApiKeyScopeEnumOrganization, nil
+	}
+	var t ApiKeyScopeEnum
+	return "", fmt.Errorf("%s is not a valid %T", s, t)
+}
+
+func (a ApiKeyScopeEnum) Ptr() *ApiKeyScopeEnum {
+	return &a
+}
+
+type OrganizationRead struct {
+	Id   string `json:"id"`
+	Name string `json:"name"`
+
+	_rawJSON json.RawMessage
+}
+
+func (o *OrganizationRead) UnmarshalJSON(data []byte) error {
+	type unmarshaler OrganizationRead
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*o = OrganizationRead(value)
+	o._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (o *OrganizationRead) String() string {
+	if len(o._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(o._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(o); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", o)
+}
+
+// The API key a request was authenticated with.
+type ApiKeyRead struct {
+	Id    string `json:"id"`
+	Label string `json:"label"`
+	// Whether the API key grants access to a single workspace or to every workspace of its organization
+	//
+	// - `WORKSPACE` - Workspace
+	// - `ORGANIZATION` - Organization
+	Scope ApiKeyScopeEnum `json:"scope"`
+	// The environment the API key is restricted to, if any
+	//
+	// - `DEVELOPMENT` - Development
+	// - `STAGING` - Staging
+	// - `PRODUCTION` - Production
+	Environment *EnvironmentEnum `json:"environment,omitempty"`
+
+	_rawJSON json.RawMessage
+}
+
+func (a *ApiKeyRead) UnmarshalJSON(data []byte) error {
+	type unmarshaler ApiKeyRead
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*a = ApiKeyRead(value)
+	a._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (a *ApiKeyRead) String() string {
+	if len(a._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(a._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(a); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", a)
+}
+
+type WorkspaceRead struct {
+	Id string `json:"id"`
+	// A name that uniquely identifies this workspace within its organization
+	Name string `json:"name"`
+	// A human-readable label for the workspace
+	Label        string            `json:"label"`
+	Organization *OrganizationRead `json:"organization,omitempty"`
+	// The API key the request was authenticated with
+	ApiKey *ApiKeyRead `json:"api_key,omitempty"`
+
+	_rawJSON json.RawMessage
+}
+
+func (w *WorkspaceRead) UnmarshalJSON(data []byte) error {
+	type unmarshaler WorkspaceRead
+	var value unmarshaler
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+	*w = WorkspaceRead(value)
+	w._rawJSON = json.RawMessage(data)
+	return nil
+}
+
+func (w *WorkspaceRead) String() string {
+	if len(w._rawJSON) > 0 {
+		if value, err := core.StringifyJSON(w._rawJSON); err == nil {
+			return value
+		}
+	}
+	if value, err := core.StringifyJSON(w); err == nil {
+		return value
+	}
+	return fmt.Sprintf("%#v", w)
+}
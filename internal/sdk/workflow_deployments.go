@@ -0,0 +1,43 @@
+package api
+
+import (
+	fmt "fmt"
+)
+
+type WorkflowDeploymentsListRequest struct {
+	// Number of results to return per page.
+	Limit *int `json:"-"`
+	// The initial index from which to return the results.
+	Offset *int `json:"-"`
+	// Which field to use when ordering the results.
+	Ordering *string `json:"-"`
+	// status
+	Status *WorkflowDeploymentsListRequestStatus `json:"-"`
+}
+
+type PatchedWorkflowReleaseTagUpdateRequest struct {
+	// The ID of the Workflow Deployment History Item to tag
+	HistoryItemId *string `json:"history_item_id,omitempty"`
+}
+
+type WorkflowDeploymentsListRequestStatus string
+
+const (
+	WorkflowDeploymentsListRequestStatusActive   WorkflowDeploymentsListRequestStatus = "ACTIVE"
+	WorkflowDeploymentsListRequestStatusArchived WorkflowDeploymentsListRequestStatus = "ARCHIVED"
+)
+
+func NewWorkflowDeploymentsListRequestStatusFromString(s string) (WorkflowDeploymentsListRequestStatus, error) {
+	switch s {
+	case "ACTIVE":
+		return WorkflowDeploymentsListRequestStatusActive, nil
+	case "ARCHIVED":
+		return WorkflowDeploymentsListRequestStatusArchived, nil
+	}
+	var t WorkflowDeploymentsListRequestStatus
+	return "", fmt.Errorf("%s is not a valid %T", s, t)
+}
+
+func (w WorkflowDeploymentsListRequestStatus) Ptr() *WorkflowDeploymentsListRequestStatus {
+	return &w
+}
@@ -0,0 +1,177 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package workflowdeployments
+
+import (
+	context "context"
+	fmt "fmt"
+	http "net/http"
+	url "net/url"
+	vellumclientgo "terraform-provider-vellum/internal/sdk"
+	core "terraform-provider-vellum/internal/sdk/core"
+)
+
+type Client struct {
+	environment *core.Environment
+	caller      *core.Caller
+	header      http.Header
+}
+
+func NewClient(opts ...core.ClientOption) *Client {
+	options := core.NewClientOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+	environment, err := options.ResolveEnvironment()
+	return &Client{
+		environment: environment,
+		caller: core.NewCaller(
+			&core.CallerParams{
+				Client:         options.HTTPClient,
+				ReadOnly:       options.ReadOnly,
+				Logging:        options.Logging,
+				TracerProvider: options.TracerProvider,
+				Cache:          options.Cache,
+				Err:            err,
+			},
+		),
+		header: options.ToHeader(),
+	}
+}
+
+// Used to list all Workflow Deployments.
+func (c *Client) List(ctx context.Context, request *vellumclientgo.WorkflowDeploymentsListRequest) (*vellumclientgo.PaginatedSlimWorkflowDeploymentList, error) {
+	return c.list(ctx, c.listURL(request))
+}
+
+// Pages returns a *core.Pager that walks every page of results, starting
+// from the given request.
+func (c *Client) Pages(request *vellumclientgo.WorkflowDeploymentsListRequest, opts ...core.PageOption) *core.Pager[*vellumclientgo.SlimWorkflowDeployment] {
+	return core.NewPager(
+		c.listURL(request),
+		func(ctx context.Context, url string) (*core.Page[*vellumclientgo.SlimWorkflowDeployment], error) {
+			response, err := c.list(ctx, url)
+			if err != nil {
+				return nil, err
+			}
+			if response == nil {
+				return nil, fmt.Errorf("%w: %s", core.ErrEmptyPage, url)
+			}
+			page := &core.Page[*vellumclientgo.SlimWorkflowDeployment]{Results: response.Results}
+			if response.Next != nil {
+				page.Next = *response.Next
+			}
+			return page, nil
+		},
+		opts...,
+	)
+}
+
+// ListAll returns the results of every page, starting from the given request.
+func (c *Client) ListAll(ctx context.Context, request *vellumclientgo.WorkflowDeploymentsListRequest, opts ...core.PageOption) ([]*vellumclientgo.SlimWorkflowDeployment, error) {
+	return c.Pages(request, opts...).All(ctx)
+}
+
+func (c *Client) listURL(request *vellumclientgo.WorkflowDeploymentsListRequest) string {
+	endpointURL := c.environment.Default + "/" + "v1/workflow-deployments"
+
+	queryParams := make(url.Values)
+	if request.Limit != nil {
+		queryParams.Add("limit", fmt.Sprintf("%v", *request.Limit))
+	}
+	if request.Offset != nil {
+		queryParams.Add("offset", fmt.Sprintf("%v", *request.Offset))
+	}
+	if request.Ordering != nil {
+		queryParams.Add("ordering", fmt.Sprintf("%v", *request.Ordering))
+	}
+	if request.Status != nil {
+		queryParams.Add("status", fmt.Sprintf("%v", *request.Status))
+	}
+	if len(queryParams) > 0 {
+		endpointURL += "?" + queryParams.Encode()
+	}
+	return endpointURL
+}
+
+func (c *Client) list(ctx context.Context, endpointURL string) (*vellumclientgo.PaginatedSlimWorkflowDeploymentList, error) {
+	var response *vellumclientgo.PaginatedSlimWorkflowDeploymentList
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodGet,
+			Headers:  c.header,
+			Response: &response,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Used to retrieve a workflow deployment given its ID or name.
+//
+// Either the Workflow Deployment's ID or its unique name
+func (c *Client) Retrieve(ctx context.Context, id string) (*vellumclientgo.WorkflowDeploymentRead, error) {
+	endpointURL := fmt.Sprintf(c.environment.Default+"/"+"v1/workflow-deployments/%v", id)
+
+	var response *vellumclientgo.WorkflowDeploymentRead
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodGet,
+			Headers:  c.header,
+			Response: &response,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Retrieve a Workflow Release Tag by tag name, associated with a specified Workflow Deployment.
+//
+// A UUID string identifying this workflow deployment.
+// The name of the Release Tag associated with this Workflow Deployment that you'd like to retrieve.
+func (c *Client) RetrieveWorkflowReleaseTag(ctx context.Context, id string, name string) (*vellumclientgo.WorkflowReleaseTagRead, error) {
+	endpointURL := fmt.Sprintf(c.environment.Default+"/"+"v1/workflow-deployments/%v/release-tags/%v", id, name)
+
+	var response *vellumclientgo.WorkflowReleaseTagRead
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodGet,
+			Headers:  c.header,
+			Response: &response,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Updates an existing Release Tag associated with the specified Workflow Deployment.
+//
+// A UUID string identifying this workflow deployment.
+// The name of the Release Tag associated with this Workflow Deployment that you'd like to update.
+func (c *Client) UpdateWorkflowReleaseTag(ctx context.Context, id string, name string, request *vellumclientgo.PatchedWorkflowReleaseTagUpdateRequest) (*vellumclientgo.WorkflowReleaseTagRead, error) {
+	endpointURL := fmt.Sprintf(c.environment.Default+"/"+"v1/workflow-deployments/%v/release-tags/%v", id, name)
+
+	var response *vellumclientgo.WorkflowReleaseTagRead
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodPatch,
+			Headers:  c.header,
+			Request:  request,
+			Response: &response,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
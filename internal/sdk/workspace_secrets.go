@@ -0,0 +1,17 @@
+package api
+
+type WorkspaceSecretCreateRequest struct {
+	// A name that uniquely identifies this secret within its workspace
+	Name string `json:"name"`
+	// A human-readable label for the secret
+	Label *string `json:"label,omitempty"`
+	// The secret's value
+	Value string `json:"value"`
+}
+
+type PatchedWorkspaceSecretUpdateRequest struct {
+	// A human-readable label for the secret
+	Label *string `json:"label,omitempty"`
+	// The secret's value
+	Value *string `json:"value,omitempty"`
+}
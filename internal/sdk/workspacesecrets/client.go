@@ -0,0 +1,121 @@
+// This file was auto-generated by Fern from our API Definition.
+
+package workspacesecrets
+
+import (
+	context "context"
+	fmt "fmt"
+	http "net/http"
+	vellumclientgo "terraform-provider-vellum/internal/sdk"
+	core "terraform-provider-vellum/internal/sdk/core"
+)
+
+type Client struct {
+	environment *core.Environment
+	caller      *core.Caller
+	header      http.Header
+}
+
+func NewClient(opts ...core.ClientOption) *Client {
+	options := core.NewClientOptions()
+	for _, opt := range opts {
+		opt(options)
+	}
+	environment, err := options.ResolveEnvironment()
+	return &Client{
+		environment: environment,
+		caller: core.NewCaller(
+			&core.CallerParams{
+				Client:         options.HTTPClient,
+				ReadOnly:       options.ReadOnly,
+				Logging:        options.Logging,
+				TracerProvider: options.TracerProvider,
+				Cache:          options.Cache,
+				Err:            err,
+			},
+		),
+		header: options.ToHeader(),
+	}
+}
+
+// Used to create a new Workspace Secret.
+func (c *Client) Create(ctx context.Context, request *vellumclientgo.WorkspaceSecretCreateRequest) (*vellumclientgo.WorkspaceSecretRead, error) {
+	endpointURL := c.environment.Default + "/" + "v1/workspace-secrets"
+
+	var response *vellumclientgo.WorkspaceSecretRead
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodPost,
+			Headers:  c.header,
+			Request:  request,
+			Response: &response,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Used to retrieve a Workspace Secret given its ID or name.
+//
+// Either the Workspace Secret's ID or its unique name
+func (c *Client) Retrieve(ctx context.Context, id string) (*vellumclientgo.WorkspaceSecretRead, error) {
+	endpointURL := fmt.Sprintf(c.environment.Default+"/"+"v1/workspace-secrets/%v", id)
+
+	var response *vellumclientgo.WorkspaceSecretRead
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodGet,
+			Headers:  c.header,
+			Response: &response,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Used to update a Workspace Secret given its ID or name.
+//
+// Either the Workspace Secret's ID or its unique name
+func (c *Client) PartialUpdate(ctx context.Context, id string, request *vellumclientgo.PatchedWorkspaceSecretUpdateRequest) (*vellumclientgo.WorkspaceSecretRead, error) {
+	endpointURL := fmt.Sprintf(c.environment.Default+"/"+"v1/workspace-secrets/%v", id)
+
+	var response *vellumclientgo.WorkspaceSecretRead
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:      endpointURL,
+			Method:   http.MethodPatch,
+			Headers:  c.header,
+			Request:  request,
+			Response: &response,
+		},
+	); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// Used to delete a Workspace Secret given its ID or name.
+//
+// Either the Workspace Secret's ID or its unique name
+func (c *Client) Destroy(ctx context.Context, id string) error {
+	endpointURL := fmt.Sprintf(c.environment.Default+"/"+"v1/workspace-secrets/%v", id)
+
+	if err := c.caller.Call(
+		ctx,
+		&core.CallParams{
+			URL:     endpointURL,
+			Method:  http.MethodDelete,
+			Headers: c.header,
+		},
+	); err != nil {
+		return err
+	}
+	return nil
+}
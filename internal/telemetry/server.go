@@ -0,0 +1,233 @@
+package telemetry
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracerName is the name of the OpenTelemetry tracer provider operations are
+// traced with.
+const TracerName = "terraform-provider-vellum/internal/telemetry"
+
+// FlushTimeout caps how long exporting the remaining spans may hold up the
+// provider stopping, in case the collector is slow or down.
+const FlushTimeout = 5 * time.Second
+
+// Terraform doesn't send the address of a resource to its provider, so
+// operations are identified by the resource's type and ID instead.
+const (
+	operationAttribute    = attribute.Key("terraform.operation")
+	resourceTypeAttribute = attribute.Key("terraform.resource.type")
+	resourceIdAttribute   = attribute.Key("terraform.resource.id")
+)
+
+// providerServer starts a span for every operation the provider runs against
+// a resource or data source, along with configuring the provider itself.
+// Spans of the Vellum API calls made along the way are its children.
+type providerServer struct {
+	tfprotov6.ProviderServer
+
+	tracer trace.Tracer
+	flush  func(context.Context) error
+
+	schemasOnce     sync.Once
+	resourceTypes   map[string]tftypes.Type
+	dataSourceTypes map[string]tftypes.Type
+}
+
+// NewProviderServer traces the given ProviderServer with the given
+// TracerProvider, calling flush, if any, when Terraform stops the provider.
+// Spans are otherwise exported in batches, without holding up operations.
+func NewProviderServer(server tfprotov6.ProviderServer, tracerProvider trace.TracerProvider, flush func(context.Context) error) tfprotov6.ProviderServer {
+	return &providerServer{
+		ProviderServer: server,
+		tracer:         tracerProvider.Tracer(TracerName),
+		flush:          flush,
+	}
+}
+
+func (s *providerServer) StopProvider(ctx context.Context, req *tfprotov6.StopProviderRequest) (*tfprotov6.StopProviderResponse, error) {
+	if s.flush != nil {
+		flushCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), FlushTimeout)
+		_ = s.flush(flushCtx)
+		cancel()
+	}
+	return s.ProviderServer.StopProvider(ctx, req)
+}
+
+func (s *providerServer) ConfigureProvider(ctx context.Context, req *tfprotov6.ConfigureProviderRequest) (*tfprotov6.ConfigureProviderResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "configure provider", trace.WithAttributes(operationAttribute.String("configure")))
+	resp, err := s.ProviderServer.ConfigureProvider(ctx, req)
+	if resp != nil {
+		s.end(span, err, resp.Diagnostics)
+	} else {
+		s.end(span, err, nil)
+	}
+	return resp, err
+}
+
+func (s *providerServer) ReadResource(ctx context.Context, req *tfprotov6.ReadResourceRequest) (*tfprotov6.ReadResourceResponse, error) {
+	ctx, span := s.start(ctx, "read", req.TypeName)
+	s.setResourceId(ctx, span, req.TypeName, req.CurrentState)
+	resp, err := s.ProviderServer.ReadResource(ctx, req)
+	if resp != nil {
+		s.end(span, err, resp.Diagnostics)
+	} else {
+		s.end(span, err, nil)
+	}
+	return resp, err
+}
+
+func (s *providerServer) PlanResourceChange(ctx context.Context, req *tfprotov6.PlanResourceChangeRequest) (*tfprotov6.PlanResourceChangeResponse, error) {
+	ctx, span := s.start(ctx, "plan", req.TypeName)
+	s.setResourceId(ctx, span, req.TypeName, req.PriorState)
+	resp, err := s.ProviderServer.PlanResourceChange(ctx, req)
+	if resp != nil {
+		s.end(span, err, resp.Diagnostics)
+	} else {
+		s.end(span, err, nil)
+	}
+	return resp, err
+}
+
+func (s *providerServer) ApplyResourceChange(ctx context.Context, req *tfprotov6.ApplyResourceChangeRequest) (*tfprotov6.ApplyResourceChangeResponse, error) {
+	operation := "update"
+	if isNull(req.PriorState) {
+		operation = "create"
+	} else if isNull(req.PlannedState) {
+		operation = "delete"
+	}
+
+	ctx, span := s.start(ctx, operation, req.TypeName)
+	s.setResourceId(ctx, span, req.TypeName, req.PriorState)
+	resp, err := s.ProviderServer.ApplyResourceChange(ctx, req)
+	if resp != nil {
+		s.setResourceId(ctx, span, req.TypeName, resp.NewState)
+		s.end(span, err, resp.Diagnostics)
+	} else {
+		s.end(span, err, nil)
+	}
+	return resp, err
+}
+
+func (s *providerServer) ImportResourceState(ctx context.Context, req *tfprotov6.ImportResourceStateRequest) (*tfprotov6.ImportResourceStateResponse, error) {
+	ctx, span := s.start(ctx, "import", req.TypeName)
+	span.SetAttributes(resourceIdAttribute.String(req.ID))
+	resp, err := s.ProviderServer.ImportResourceState(ctx, req)
+	if resp != nil {
+		s.end(span, err, resp.Diagnostics)
+	} else {
+		s.end(span, err, nil)
+	}
+	return resp, err
+}
+
+func (s *providerServer) ReadDataSource(ctx context.Context, req *tfprotov6.ReadDataSourceRequest) (*tfprotov6.ReadDataSourceResponse, error) {
+	ctx, span := s.start(ctx, "read", req.TypeName)
+	resp, err := s.ProviderServer.ReadDataSource(ctx, req)
+	if resp != nil {
+		s.setDataSourceId(ctx, span, req.TypeName, resp.State)
+		s.end(span, err, resp.Diagnostics)
+	} else {
+		s.end(span, err, nil)
+	}
+	return resp, err
+}
+
+func (s *providerServer) start(ctx context.Context, operation string, typeName string) (context.Context, trace.Span) {
+	return s.tracer.Start(ctx, operation+" "+typeName, trace.WithAttributes(
+		operationAttribute.String(operation),
+		resourceTypeAttribute.String(typeName),
+	))
+}
+
+// end ends the span, marking it as failed when the operation returned an
+// error or an error diagnostic.
+func (s *providerServer) end(span trace.Span, err error, diagnostics []*tfprotov6.Diagnostic) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	for _, diagnostic := range diagnostics {
+		if diagnostic != nil && diagnostic.Severity == tfprotov6.DiagnosticSeverityError {
+			span.SetStatus(codes.Error, diagnostic.Summary)
+			span.AddEvent("error diagnostic", trace.WithAttributes(
+				attribute.String("summary", diagnostic.Summary),
+				attribute.String("detail", diagnostic.Detail),
+			))
+		}
+	}
+	span.End()
+}
+
+// setResourceId records the ID of the resource held by the given state, if
+// it has one.
+func (s *providerServer) setResourceId(ctx context.Context, span trace.Span, typeName string, state *tfprotov6.DynamicValue) {
+	s.loadSchemaTypes(ctx)
+	setId(span, s.resourceTypes[typeName], state)
+}
+
+// setDataSourceId records the ID of the data source held by the given state,
+// if it has one.
+func (s *providerServer) setDataSourceId(ctx context.Context, span trace.Span, typeName string, state *tfprotov6.DynamicValue) {
+	s.loadSchemaTypes(ctx)
+	setId(span, s.dataSourceTypes[typeName], state)
+}
+
+func setId(span trace.Span, typ tftypes.Type, state *tfprotov6.DynamicValue) {
+	if typ == nil || isNull(state) {
+		return
+	}
+
+	value, err := state.Unmarshal(typ)
+	if err != nil || !value.IsKnown() || value.IsNull() {
+		return
+	}
+	var attributes map[string]tftypes.Value
+	if err := value.As(&attributes); err != nil {
+		return
+	}
+	idValue, ok := attributes["id"]
+	if !ok || !idValue.IsKnown() || idValue.IsNull() || !idValue.Type().Is(tftypes.String) {
+		return
+	}
+	var id string
+	if err := idValue.As(&id); err != nil {
+		return
+	}
+	span.SetAttributes(resourceIdAttribute.String(id))
+}
+
+// loadSchemaTypes loads the type of every resource and data source, keyed by
+// type name, the first time it's called.
+func (s *providerServer) loadSchemaTypes(ctx context.Context) {
+	s.schemasOnce.Do(func() {
+		s.resourceTypes = map[string]tftypes.Type{}
+		s.dataSourceTypes = map[string]tftypes.Type{}
+		resp, err := s.ProviderServer.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+		if err != nil || resp == nil {
+			return
+		}
+		for typeName, schema := range resp.ResourceSchemas {
+			s.resourceTypes[typeName] = schema.ValueType()
+		}
+		for typeName, schema := range resp.DataSourceSchemas {
+			s.dataSourceTypes[typeName] = schema.ValueType()
+		}
+	})
+}
+
+func isNull(value *tfprotov6.DynamicValue) bool {
+	if value == nil {
+		return true
+	}
+	null, err := value.IsNull()
+	return err == nil && null
+}
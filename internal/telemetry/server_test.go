@@ -0,0 +1,137 @@
+package telemetry
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+
+	"terraform-provider-vellum/internal/provider"
+)
+
+// newConfig returns a configuration of the given object type with every
+// attribute null but the given ones.
+func newConfig(t *testing.T, typ tftypes.Type, attributes map[string]tftypes.Value) *tfprotov6.DynamicValue {
+	t.Helper()
+	objectType, ok := typ.(tftypes.Object)
+	if !ok {
+		t.Fatalf("expected an object type, got %s", typ)
+	}
+	values := map[string]tftypes.Value{}
+	for name, attributeType := range objectType.AttributeTypes {
+		values[name] = tftypes.NewValue(attributeType, nil)
+		if value, ok := attributes[name]; ok {
+			values[name] = value
+		}
+	}
+	config, err := tfprotov6.NewDynamicValue(typ, tftypes.NewValue(typ, values))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &config
+}
+
+func TestProviderServerTracesOperationsAndTheirAPICalls(t *testing.T) {
+	vellum := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/workspaces/current" {
+			http.NotFound(w, r)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		w.Header().Set("X-Request-Id", "request-1")
+		_, _ = w.Write([]byte(`{"id":"workspace-1","name":"default","label":"Default"}`))
+	}))
+	defer vellum.Close()
+	t.Setenv("VELLUM_API_KEY", "key")
+	t.Setenv("VELLUM_BASE_URL", "")
+
+	// Spans are batched for longer than the test runs, so only flushing
+	// exports them.
+	exporter := tracetest.NewInMemoryExporter()
+	tracerProvider := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exporter, sdktrace.WithBatchTimeout(time.Hour)))
+	defer func() { _ = tracerProvider.Shutdown(context.Background()) }()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(tracerProvider)
+	defer otel.SetTracerProvider(previous)
+
+	server := NewProviderServer(providerserver.NewProtocol6(provider.New("test")())(), tracerProvider, tracerProvider.ForceFlush)
+	ctx := context.Background()
+
+	schemas, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	configureResp, err := server.ConfigureProvider(ctx, &tfprotov6.ConfigureProviderRequest{
+		Config: newConfig(t, schemas.Provider.ValueType(), map[string]tftypes.Value{
+			"base_url": tftypes.NewValue(tftypes.String, vellum.URL),
+		}),
+	})
+	if err != nil || len(configureResp.Diagnostics) > 0 {
+		t.Fatalf("configuring the provider failed: %v %v", err, configureResp.Diagnostics)
+	}
+
+	readResp, err := server.ReadDataSource(ctx, &tfprotov6.ReadDataSourceRequest{
+		TypeName: "vellum_workspace",
+		Config:   newConfig(t, schemas.DataSourceSchemas["vellum_workspace"].ValueType(), nil),
+	})
+	if err != nil || len(readResp.Diagnostics) > 0 {
+		t.Fatalf("reading vellum_workspace failed: %v %v", err, readResp.Diagnostics)
+	}
+
+	if spans := exporter.GetSpans(); len(spans) > 0 {
+		t.Errorf("exported %d spans before the provider stopped, want them batched", len(spans))
+	}
+	if _, err := server.StopProvider(ctx, &tfprotov6.StopProviderRequest{}); err != nil {
+		t.Fatal(err)
+	}
+
+	spans := map[string]tracetest.SpanStub{}
+	for _, span := range exporter.GetSpans() {
+		spans[span.Name] = span
+	}
+	if _, ok := spans["configure provider"]; !ok {
+		t.Errorf("missing the configure provider span, got %v", spans)
+	}
+	read, ok := spans["read vellum_workspace"]
+	if !ok {
+		t.Fatalf("missing the read vellum_workspace span, got %v", spans)
+	}
+	call, ok := spans[http.MethodGet]
+	if !ok {
+		t.Fatalf("missing the Vellum API call span, got %v", spans)
+	}
+
+	if call.Parent.SpanID() != read.SpanContext.SpanID() || call.SpanContext.TraceID() != read.SpanContext.TraceID() {
+		t.Errorf("the Vellum API call span isn't a child of the read vellum_workspace span")
+	}
+	if call.SpanKind != trace.SpanKindClient {
+		t.Errorf("got a %s Vellum API call span, want a client span", call.SpanKind)
+	}
+
+	attributes := map[string]string{}
+	for _, attribute := range append(read.Attributes, call.Attributes...) {
+		attributes[string(attribute.Key)] = attribute.Value.Emit()
+	}
+	for key, want := range map[string]string{
+		"terraform.operation":       "read",
+		"terraform.resource.type":   "vellum_workspace",
+		"terraform.resource.id":     "workspace-1",
+		"http.request.method":       http.MethodGet,
+		"url.full":                  vellum.URL + "/v1/workspaces/current",
+		"vellum.request_id":         "request-1",
+		"http.request.resend_count": "0",
+	} {
+		if got := attributes[key]; got != want {
+			t.Errorf("got %s %q, want %q", key, got, want)
+		}
+	}
+}
@@ -9,8 +9,12 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6/tf6server"
+	"go.opentelemetry.io/otel"
 
 	"terraform-provider-vellum/internal/provider"
+	"terraform-provider-vellum/internal/telemetry"
 )
 
 // Run "go generate" to format example terraform files and generate the docs for the registry/website
@@ -38,12 +42,42 @@ func main() {
 	flag.BoolVar(&debug, "debug", false, "set to true to run the provider with support for debuggers like delve")
 	flag.Parse()
 
-	opts := providerserver.ServeOpts{
-		Address: "hashicorp.com/ai/vellum",
-		Debug:   debug,
+	ctx := context.Background()
+
+	tracerProvider, err := telemetry.NewTracerProvider(ctx, version)
+	if err != nil {
+		log.Fatal(err.Error())
+	}
+	if tracerProvider != nil {
+		// The SDK traces Vellum API calls with the global TracerProvider, as
+		// children of the spans of the operations they're made by.
+		otel.SetTracerProvider(tracerProvider)
 	}
 
-	err := providerserver.Serve(context.Background(), provider.New(version), opts)
+	var opts []tf6server.ServeOpt
+	if debug {
+		opts = append(opts, tf6server.WithManagedDebug())
+	}
+
+	err = tf6server.Serve(
+		"hashicorp.com/ai/vellum",
+		func() tfprotov6.ProviderServer {
+			server := providerserver.NewProtocol6(provider.New(version)())()
+			if tracerProvider == nil {
+				return server
+			}
+			return telemetry.NewProviderServer(server, tracerProvider, tracerProvider.ForceFlush)
+		},
+		opts...,
+	)
+
+	if tracerProvider != nil {
+		// Export the spans still batched, without waiting on a collector
+		// that's down for longer than it takes Terraform to give up on us.
+		shutdownCtx, cancel := context.WithTimeout(ctx, telemetry.FlushTimeout)
+		_ = tracerProvider.Shutdown(shutdownCtx)
+		cancel()
+	}
 
 	if err != nil {
 		log.Fatal(err.Error())